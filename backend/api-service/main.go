@@ -1,39 +1,93 @@
 package main
 
 import (
+	"context"
 	"log"
-	"os"
+	"net"
 
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aegis-yield/backend/data-aggregator"
+	"github.com/aegis-yield/backend/pkg/config"
+	"github.com/aegis-yield/backend/pkg/history"
+	"github.com/aegis-yield/backend/web3-client"
 )
 
+// server holds the dependencies shared by the API handlers
+type server struct {
+	portfolio *aggregator.PortfolioReader
+	data      *aggregator.DataAggregator
+	history   *history.Store
+}
+
 func main() {
-	// Load environment variables
-	if err := godotenv.Load(); err != nil {
-		log.Println("No .env file found")
+	// Load and validate configuration
+	cfg, err := config.LoadConfig()
+	if err == nil {
+		err = cfg.ValidateAPI()
+	}
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	// Get configuration
-	port := os.Getenv("API_PORT")
-	if port == "" {
-		port = "8080"
+	artifacts, err := web3client.LoadDeploymentArtifacts(cfg.DeploymentArtifactsPath)
+	if err != nil {
+		log.Fatalf("Failed to load deployment artifacts: %v", err)
+	}
+
+	// Connect to every configured RPC endpoint with health checks and failover
+	rpcClient, err := web3client.DialMultiClient(context.Background(), cfg.BaseRPCURLs, web3client.MultiClientConfig{
+		HealthCheckInterval: cfg.RPCHealthCheckInterval,
+		MaxBlockLag:         cfg.RPCMaxBlockLag,
+		MaxLatency:          cfg.RPCMaxLatency,
+		RequestTimeout:      cfg.RPCRequestTimeout,
+		Quorum:              cfg.RPCQuorum,
+	}, logrus.StandardLogger())
+	if err != nil {
+		log.Fatalf("Failed to connect to Base RPC: %v", err)
+	}
+	defer rpcClient.Close()
+
+	// Rebalance history written by the keeper bot
+	historyStore, err := history.Open(cfg.HistoryDBPath)
+	if err != nil {
+		log.Fatalf("Failed to open rebalance history: %v", err)
+	}
+	defer historyStore.Close()
+
+	// Dashboard polling shares one set of chain reads per cache TTL
+	portfolio := aggregator.NewPortfolioReader(rpcClient, artifacts.ControllerProxy, artifacts.VaultProxy, cfg.APICacheTTL)
+
+	// Portfolio and gas data with provenance, each source under its own timeout
+	registry := aggregator.NewRegistry()
+	for _, source := range []aggregator.Source{aggregator.NewControllerSource(portfolio), aggregator.NewFeeHistorySource(rpcClient)} {
+		if err := registry.Register(source, cfg.AggregatorSourceTimeout); err != nil {
+			log.Fatalf("Failed to register data source: %v", err)
+		}
+	}
+
+	srv := &server{
+		portfolio: portfolio,
+		data:      aggregator.NewDataAggregator(registry),
+		history:   historyStore,
 	}
 
 	// Create Gin router
 	router := gin.Default()
 
 	// Setup routes
-	setupRoutes(router)
+	setupRoutes(router, srv)
 
 	// Start server
-	log.Printf("Starting API server on port %s...", port)
-	if err := router.Run(":" + port); err != nil {
+	addr := net.JoinHostPort(cfg.APIHost, cfg.APIPort)
+	log.Printf("Starting API server on %s...", addr)
+	if err := router.Run(addr); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
 
-func setupRoutes(router *gin.Engine) {
+func setupRoutes(router *gin.Engine, srv *server) {
 	// Health check
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{
@@ -45,68 +99,18 @@ func setupRoutes(router *gin.Engine) {
 	v1 := router.Group("/api/v1")
 	{
 		// Portfolio endpoints
-		v1.GET("/portfolio", getPortfolio)
-		v1.GET("/portfolio/metrics", getPortfolioMetrics)
-		
-		// Strategy endpoints
-		v1.GET("/strategies", getStrategies)
-		v1.GET("/strategies/:name", getStrategy)
-		
-		// Rebalancing endpoints
-		v1.GET("/rebalances", getRebalanceHistory)
-		v1.GET("/rebalances/latest", getLatestRebalance)
-	}
-}
+		v1.GET("/portfolio", srv.getPortfolio)
+		v1.GET("/portfolio/metrics", srv.getPortfolioMetrics)
 
-func getPortfolio(c *gin.Context) {
-	// TODO: Implement
-	c.JSON(200, gin.H{
-		"total_assets": 1000000,
-		"strategies": []gin.H{
-			{"name": "aave", "allocation": 400000},
-			{"name": "lido", "allocation": 300000},
-			{"name": "delta", "allocation": 300000},
-		},
-	})
-}
-
-func getPortfolioMetrics(c *gin.Context) {
-	// TODO: Implement
-	c.JSON(200, gin.H{
-		"apy": 0.056,
-		"risk_score": 32.5,
-		"sharpe_ratio": 1.8,
-	})
-}
+		// Gas market endpoint
+		v1.GET("/market", srv.getMarket)
 
-func getStrategies(c *gin.Context) {
-	// TODO: Implement
-	c.JSON(200, gin.H{
-		"strategies": []string{"aave", "lido", "delta"},
-	})
-}
-
-func getStrategy(c *gin.Context) {
-	name := c.Param("name")
-	// TODO: Implement
-	c.JSON(200, gin.H{
-		"name": name,
-		"apy": 0.05,
-		"risk_score": 25,
-	})
-}
-
-func getRebalanceHistory(c *gin.Context) {
-	// TODO: Implement
-	c.JSON(200, gin.H{
-		"rebalances": []gin.H{},
-	})
-}
+		// Strategy endpoints
+		v1.GET("/strategies", srv.getStrategies)
+		v1.GET("/strategies/:name", srv.getStrategy)
 
-func getLatestRebalance(c *gin.Context) {
-	// TODO: Implement
-	c.JSON(200, gin.H{
-		"timestamp": "2024-01-01T00:00:00Z",
-		"status": "success",
-	})
+		// Rebalancing endpoints
+		v1.GET("/rebalances", srv.getRebalanceHistory)
+		v1.GET("/rebalances/latest", srv.getLatestRebalance)
+	}
 }
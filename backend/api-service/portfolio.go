@@ -0,0 +1,154 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aegis-yield/backend/data-aggregator"
+)
+
+func (s *server) getPortfolio(c *gin.Context) {
+	portfolio, ok := s.readPortfolio(c)
+	if !ok {
+		return
+	}
+
+	strategies := make([]gin.H, len(portfolio.Strategies))
+	for i, strategy := range portfolio.Strategies {
+		strategies[i] = gin.H{
+			"name":       strategy.Name,
+			"address":    strategy.Address.Hex(),
+			"allocation": strategy.Allocation.String(),
+			"weight":     strategy.Weight,
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"block_number": portfolio.BlockNumber,
+		"decimals":     portfolio.Vault.Decimals,
+		"total_assets": portfolio.TotalAssets.String(),
+		"idle_assets":  portfolio.IdleAssets.String(),
+		"vault": gin.H{
+			"address":      portfolio.Vault.Address.Hex(),
+			"total_assets": portfolio.Vault.TotalAssets.String(),
+			"total_supply": portfolio.Vault.TotalSupply.String(),
+			"share_price":  portfolio.Vault.SharePrice,
+			"paused":       portfolio.Vault.Paused,
+		},
+		"strategies": strategies,
+	})
+}
+
+func (s *server) getPortfolioMetrics(c *gin.Context) {
+	portfolio, ok := s.readPortfolio(c)
+	if !ok {
+		return
+	}
+
+	// Per-strategy values with the source and block they were read from
+	data, err := s.data.FetchPortfolioData(c.Request.Context())
+	if err != nil {
+		log.Printf("Failed to read portfolio data: %v", err)
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "failed to read portfolio from chain"})
+		return
+	}
+
+	strategies := make(gin.H, len(data.StrategyAllocations))
+	for name, allocation := range data.StrategyAllocations {
+		strategies[name] = gin.H{
+			"allocation": allocation,
+			"apy":        data.APYs[name],
+			"risk_score": data.RiskScores[name],
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"block_number":        portfolio.BlockNumber,
+		"apy":                 portfolio.APY,
+		"risk_score":          portfolio.RiskScore,
+		"share_price":         portfolio.Vault.SharePrice,
+		"performance_fee_bps": portfolio.Vault.PerformanceFee.Uint64(),
+		"management_fee_bps":  portfolio.Vault.ManagementFee.Uint64(),
+		"total_assets":        data.TotalAssets,
+		"strategies":          strategies,
+	})
+}
+
+// getMarket reports the next block's base fee and recent priority fees
+func (s *server) getMarket(c *gin.Context) {
+	market, err := s.data.FetchMarketData(c.Request.Context())
+	if err != nil {
+		log.Printf("Failed to read market data: %v", err)
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "failed to read market data"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"base_fee_gwei":     market.BaseFee,
+		"priority_fee_gwei": market.PriorityFee,
+	})
+}
+
+func (s *server) getStrategies(c *gin.Context) {
+	portfolio, ok := s.readPortfolio(c)
+	if !ok {
+		return
+	}
+
+	strategies := make([]gin.H, len(portfolio.Strategies))
+	for i := range portfolio.Strategies {
+		strategies[i] = strategyJSON(&portfolio.Strategies[i])
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"block_number": portfolio.BlockNumber,
+		"strategies":   strategies,
+	})
+}
+
+// getStrategy resolves :name by on-chain name() or by address
+func (s *server) getStrategy(c *gin.Context) {
+	name := c.Param("name")
+
+	strategy, err := s.portfolio.Strategy(c.Request.Context(), name)
+	if errors.Is(err, aggregator.ErrStrategyNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "strategy " + name + " not found"})
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to read strategy %s: %v", name, err)
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "failed to read strategy from chain"})
+		return
+	}
+
+	c.JSON(http.StatusOK, strategyJSON(strategy))
+}
+
+// readPortfolio returns the cached portfolio, writing an error response on failure
+func (s *server) readPortfolio(c *gin.Context) (*aggregator.Portfolio, bool) {
+	portfolio, err := s.portfolio.Portfolio(c.Request.Context())
+	if err != nil {
+		log.Printf("Failed to read portfolio: %v", err)
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "failed to read portfolio from chain"})
+		return nil, false
+	}
+	return portfolio, true
+}
+
+func strategyJSON(strategy *aggregator.StrategyState) gin.H {
+	return gin.H{
+		"name":                 strategy.Name,
+		"address":              strategy.Address.Hex(),
+		"active":               strategy.Active,
+		"apy":                  float64(strategy.APY.Uint64()) / 10000,
+		"risk_score":           strategy.RiskScore.Uint64(),
+		"allocation":           strategy.Allocation.String(),
+		"allocation_limit_bps": strategy.AllocationLimit.Uint64(),
+		"weight":               strategy.Weight,
+		"total_assets":         strategy.TotalAssets.String(),
+		"available_liquidity":  strategy.AvailableLiquidity.String(),
+	}
+}
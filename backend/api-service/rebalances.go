@@ -0,0 +1,125 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aegis-yield/backend/pkg/history"
+)
+
+// Rebalance history pagination limits
+const (
+	defaultRebalanceLimit = 20
+	maxRebalanceLimit     = 100
+)
+
+// getRebalanceHistory lists recorded rebalance runs, newest first.
+//
+// Query parameters: limit (default 20, max 100), offset, status (comma-separated
+// succeeded, skipped, failed), from and to (RFC 3339 start times, inclusive).
+func (s *server) getRebalanceHistory(c *gin.Context) {
+	query, err := parseRebalanceQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	query.Limit = defaultRebalanceLimit
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxRebalanceLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("limit must be between 1 and %d", maxRebalanceLimit)})
+			return
+		}
+		query.Limit = limit
+	}
+	if value := c.Query("offset"); value != "" {
+		offset, err := strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "offset must be a non-negative integer"})
+			return
+		}
+		query.Offset = offset
+	}
+
+	records, total, err := s.history.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read rebalance history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"rebalances": records,
+		"total":      total,
+		"limit":      query.Limit,
+		"offset":     query.Offset,
+	})
+}
+
+// getLatestRebalance returns the most recent rebalance run. It accepts the same
+// status, from and to filters as getRebalanceHistory.
+func (s *server) getLatestRebalance(c *gin.Context) {
+	query, err := parseRebalanceQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	record, err := s.history.Latest(c.Request.Context(), query)
+	if errors.Is(err, history.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no rebalances recorded"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read rebalance history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, record)
+}
+
+// parseRebalanceQuery reads the status and time range filters
+func parseRebalanceQuery(c *gin.Context) (history.Query, error) {
+	var query history.Query
+
+	if value := c.Query("status"); value != "" {
+		for _, name := range strings.Split(value, ",") {
+			status, err := history.ParseStatus(strings.TrimSpace(name))
+			if err != nil {
+				return query, err
+			}
+			query.Statuses = append(query.Statuses, status)
+		}
+	}
+
+	var err error
+	if query.Since, err = parseTime(c, "from"); err != nil {
+		return query, err
+	}
+	if query.Until, err = parseTime(c, "to"); err != nil {
+		return query, err
+	}
+	if !query.Since.IsZero() && !query.Until.IsZero() && query.Until.Before(query.Since) {
+		return query, errors.New("to must not be before from")
+	}
+
+	return query, nil
+}
+
+func parseTime(c *gin.Context, key string) (time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be an RFC 3339 timestamp such as 2024-01-01T00:00:00Z", key)
+	}
+	return parsed, nil
+}
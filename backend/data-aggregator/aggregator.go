@@ -2,69 +2,310 @@ package aggregator
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 )
 
+// Fields provided by the built-in sources
+const (
+	FieldPortfolio   = "portfolio"         // *Portfolio
+	FieldETHPrice    = "eth_usd"           // float64, USD
+	FieldUSDCPrice   = "usdc_usd"          // float64, USD
+	FieldBaseFee     = "base_fee_gwei"     // float64, next block's base fee
+	FieldPriorityFee = "priority_fee_gwei" // float64, median recent priority fee
+
+	FieldSequencerUptime = "sequencer_uptime" // time.Duration since the L2 sequencer came up
+)
+
+// DefaultSourceTimeout bounds a single source fetch when none is registered
+const DefaultSourceTimeout = 5 * time.Second
+
+// Provenance records where and when a value was observed
+type Provenance struct {
+	Source      string    `json:"source"`
+	BlockNumber uint64    `json:"block_number"`
+	Timestamp   time.Time `json:"timestamp"` // When the value was last updated on-chain, or read for contract state
+}
+
+// Observed is a value with its provenance
+type Observed[T any] struct {
+	Value T `json:"value"`
+	Provenance
+}
+
+// Valid reports whether the value was observed by a source
+func (o Observed[T]) Valid() bool {
+	return o.Source != ""
+}
+
+// Observation is a single field reported by a source
+type Observation struct {
+	Field string
+	Value interface{}
+	Provenance
+}
+
+// Source provides one or more fields
+type Source interface {
+	// Name identifies the source in provenance and errors
+	Name() string
+	// Provides lists the fields the source reports
+	Provides() []string
+	// Fetch reads the source
+	Fetch(ctx context.Context) ([]Observation, error)
+}
+
+// SourceError is a failed source fetch
+type SourceError struct {
+	Source string
+	Err    error
+}
+
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("source %s: %v", e.Source, e.Err)
+}
+
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+// registeredSource is a source and its fetch timeout
+type registeredSource struct {
+	source  Source
+	timeout time.Duration
+}
+
+// Registry holds the sources available to a DataAggregator
+type Registry struct {
+	mu      sync.RWMutex
+	sources []registeredSource
+}
+
+// NewRegistry creates an empty source registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a source with its fetch timeout. A zero timeout uses DefaultSourceTimeout.
+func (r *Registry) Register(source Source, timeout time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, registered := range r.sources {
+		if registered.source.Name() == source.Name() {
+			return fmt.Errorf("source %s is already registered", source.Name())
+		}
+	}
+	if timeout <= 0 {
+		timeout = DefaultSourceTimeout
+	}
+
+	r.sources = append(r.sources, registeredSource{source: source, timeout: timeout})
+	return nil
+}
+
+// providing returns the sources that report any of the given fields
+func (r *Registry) providing(fields []string) []registeredSource {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	wanted := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		wanted[field] = true
+	}
+
+	var sources []registeredSource
+	for _, registered := range r.sources {
+		for _, field := range registered.source.Provides() {
+			if wanted[field] {
+				sources = append(sources, registered)
+				break
+			}
+		}
+	}
+	return sources
+}
+
+// available returns the fields some registered source provides
+func (r *Registry) available(fields ...string) []string {
+	var available []string
+	for _, field := range fields {
+		if len(r.providing([]string{field})) > 0 {
+			available = append(available, field)
+		}
+	}
+	return available
+}
+
 // DataAggregator collects data from multiple sources
 type DataAggregator struct {
-	// TODO: Add data sources
+	registry *Registry
 }
 
 // PortfolioData represents the current portfolio state
 type PortfolioData struct {
-	TotalAssets       float64
-	StrategyAllocations map[string]float64
-	APYs              map[string]float64
-	RiskScores        map[string]float64
-	Timestamp         time.Time
+	TotalAssets         Observed[float64]            // Asset units
+	StrategyAllocations map[string]Observed[float64] // By strategy name, asset units
+	APYs                map[string]Observed[float64] // By strategy name, fraction
+	RiskScores          map[string]Observed[float64] // By strategy name, 0-100
+	Timestamp           time.Time
 }
 
 // MarketData represents market conditions
 type MarketData struct {
-	ETHPrice    float64
-	USDCPrice   float64
-	GasPrice    float64
-	Volatility  float64
-	Timestamp   time.Time
+	ETHPrice    Observed[float64] `json:"eth_usd"`           // USD
+	USDCPrice   Observed[float64] `json:"usdc_usd"`          // USD
+	BaseFee     Observed[float64] `json:"base_fee_gwei"`     // Gwei
+	PriorityFee Observed[float64] `json:"priority_fee_gwei"` // Gwei
+
+	SequencerUptime Observed[time.Duration] `json:"sequencer_uptime"`
+	Timestamp       time.Time               `json:"timestamp"`
+}
+
+// NewDataAggregator creates a new data aggregator over the registered sources
+func NewDataAggregator(registry *Registry) *DataAggregator {
+	return &DataAggregator{registry: registry}
 }
 
-// NewDataAggregator creates a new data aggregator
-func NewDataAggregator() *DataAggregator {
-	return &DataAggregator{}
+// Provides reports whether a registered source provides field. It is false for a nil
+// aggregator.
+func (da *DataAggregator) Provides(field string) bool {
+	return da != nil && len(da.registry.providing([]string{field})) > 0
+}
+
+// Collect fetches every source that provides one of fields concurrently, each under
+// its own timeout, and returns the observations by field. Observations from sources
+// that succeeded are returned even when others fail; failures are joined
+// *SourceError values.
+func (da *DataAggregator) Collect(ctx context.Context, fields ...string) (map[string]Observation, error) {
+	sources := da.registry.providing(fields)
+
+	type result struct {
+		source       string
+		observations []Observation
+		err          error
+	}
+	results := make(chan result, len(sources))
+
+	for _, registered := range sources {
+		go func(registered registeredSource) {
+			sourceCtx, cancel := context.WithTimeout(ctx, registered.timeout)
+			defer cancel()
+
+			observations, err := registered.source.Fetch(sourceCtx)
+			results <- result{source: registered.source.Name(), observations: observations, err: err}
+		}(registered)
+	}
+
+	observed := make(map[string]Observation, len(fields))
+	var errs []error
+	for range sources {
+		res := <-results
+		if res.err != nil {
+			errs = append(errs, &SourceError{Source: res.source, Err: res.err})
+			continue
+		}
+		for _, observation := range res.observations {
+			observed[observation.Field] = observation
+		}
+	}
+
+	provided := make(map[string]bool)
+	for _, registered := range sources {
+		for _, field := range registered.source.Provides() {
+			provided[field] = true
+		}
+	}
+	for _, field := range fields {
+		if !provided[field] {
+			errs = append(errs, fmt.Errorf("no registered source provides %s", field))
+		}
+	}
+
+	return observed, errors.Join(errs...)
 }
 
 // FetchPortfolioData fetches current portfolio state
 func (da *DataAggregator) FetchPortfolioData(ctx context.Context) (*PortfolioData, error) {
-	// TODO: Implement fetching from blockchain
-	return &PortfolioData{
-		TotalAssets: 1000000.0,
-		StrategyAllocations: map[string]float64{
-			"aave":  400000.0,
-			"lido":  300000.0,
-			"delta": 300000.0,
-		},
-		APYs: map[string]float64{
-			"aave":  0.05,
-			"lido":  0.04,
-			"delta": 0.08,
-		},
-		RiskScores: map[string]float64{
-			"aave":  25.0,
-			"lido":  30.0,
-			"delta": 45.0,
-		},
-		Timestamp: time.Now(),
-	}, nil
-}
-
-// FetchMarketData fetches current market conditions
+	observed, err := da.Collect(ctx, FieldPortfolio)
+	if err != nil {
+		return nil, err
+	}
+
+	observation := observed[FieldPortfolio]
+	portfolio, ok := observation.Value.(*Portfolio)
+	if !ok {
+		return nil, fmt.Errorf("source %s reported %T for %s", observation.Source, observation.Value, FieldPortfolio)
+	}
+
+	unit := func(value float64) Observed[float64] {
+		return Observed[float64]{Value: value, Provenance: observation.Provenance}
+	}
+
+	data := &PortfolioData{
+		TotalAssets:         unit(toUnits(portfolio.TotalAssets, portfolio.Vault.Decimals)),
+		StrategyAllocations: make(map[string]Observed[float64], len(portfolio.Strategies)),
+		APYs:                make(map[string]Observed[float64], len(portfolio.Strategies)),
+		RiskScores:          make(map[string]Observed[float64], len(portfolio.Strategies)),
+		Timestamp:           time.Now(),
+	}
+	for _, strategy := range portfolio.Strategies {
+		data.StrategyAllocations[strategy.Name] = unit(toUnits(strategy.Allocation, portfolio.Vault.Decimals))
+		data.APYs[strategy.Name] = unit(float64(strategy.APY.Uint64()) / basisPoints)
+		data.RiskScores[strategy.Name] = unit(float64(strategy.RiskScore.Uint64()))
+	}
+
+	return data, nil
+}
+
+// FetchMarketData fetches current market conditions from the registered sources.
+// Fields whose source failed or is not registered are left unset (Valid reports
+// false); failures are returned alongside the data, and data a source rejected as
+// unsafe can be found with errors.As(err, **UnsafeDataError).
 func (da *DataAggregator) FetchMarketData(ctx context.Context) (*MarketData, error) {
-	// TODO: Implement fetching from oracles and APIs
-	return &MarketData{
-		ETHPrice:   2000.0,
-		USDCPrice:  1.0,
-		GasPrice:   20.0,
-		Volatility: 0.15,
-		Timestamp:  time.Now(),
-	}, nil
+	fields := da.registry.available(FieldETHPrice, FieldUSDCPrice, FieldBaseFee, FieldPriorityFee, FieldSequencerUptime)
+	observed, err := da.Collect(ctx, fields...)
+
+	data := &MarketData{
+		ETHPrice:        observedValue[float64](observed, FieldETHPrice),
+		USDCPrice:       observedValue[float64](observed, FieldUSDCPrice),
+		BaseFee:         observedValue[float64](observed, FieldBaseFee),
+		PriorityFee:     observedValue[float64](observed, FieldPriorityFee),
+		SequencerUptime: observedValue[time.Duration](observed, FieldSequencerUptime),
+		Timestamp:       time.Now(),
+	}
+
+	return data, err
+}
+
+// ETHPrice returns the ETH/USD price from the registered sources
+func (da *DataAggregator) ETHPrice(ctx context.Context) (Observed[float64], error) {
+	observed, err := da.Collect(ctx, FieldETHPrice)
+	if err != nil {
+		return Observed[float64]{}, err
+	}
+	return observedValue[float64](observed, FieldETHPrice), nil
+}
+
+// USDCPrice returns the USDC/USD price from the registered sources
+func (da *DataAggregator) USDCPrice(ctx context.Context) (Observed[float64], error) {
+	observed, err := da.Collect(ctx, FieldUSDCPrice)
+	if err != nil {
+		return Observed[float64]{}, err
+	}
+	return observedValue[float64](observed, FieldUSDCPrice), nil
+}
+
+func observedValue[T any](observed map[string]Observation, field string) Observed[T] {
+	observation, ok := observed[field]
+	if !ok {
+		return Observed[T]{}
+	}
+	value, ok := observation.Value.(T)
+	if !ok {
+		return Observed[T]{}
+	}
+	return Observed[T]{Value: value, Provenance: observation.Provenance}
 }
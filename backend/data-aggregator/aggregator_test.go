@@ -0,0 +1,217 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var observedAt = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// stubSource reports value for each of its fields at block, after delay. When barrier
+// is set, every fetch waits for the others to start first.
+type stubSource struct {
+	name    string
+	fields  []string
+	value   interface{}
+	block   uint64
+	delay   time.Duration
+	barrier *sync.WaitGroup
+}
+
+func (s *stubSource) Name() string       { return s.name }
+func (s *stubSource) Provides() []string { return s.fields }
+
+func (s *stubSource) Fetch(ctx context.Context) ([]Observation, error) {
+	if s.barrier != nil {
+		s.barrier.Done()
+		waited := make(chan struct{})
+		go func() { s.barrier.Wait(); close(waited) }()
+		select {
+		case <-waited:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	observations := make([]Observation, len(s.fields))
+	for i, field := range s.fields {
+		observations[i] = Observation{
+			Field:      field,
+			Value:      s.value,
+			Provenance: Provenance{Source: s.name, BlockNumber: s.block, Timestamp: observedAt},
+		}
+	}
+	return observations, nil
+}
+
+func newAggregator(t *testing.T, timeout time.Duration, sources ...Source) *DataAggregator {
+	t.Helper()
+	registry := NewRegistry()
+	for _, source := range sources {
+		if err := registry.Register(source, timeout); err != nil {
+			t.Fatalf("Register: %v", err)
+		}
+	}
+	return NewDataAggregator(registry)
+}
+
+func TestRegistryRejectsDuplicateNames(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register(&stubSource{name: "a"}, 0); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if err := registry.Register(&stubSource{name: "a"}, time.Second); err == nil {
+		t.Fatal("Register accepted a second source named a")
+	}
+	if timeout := registry.sources[0].timeout; timeout != DefaultSourceTimeout {
+		t.Errorf("timeout = %s, want the default %s", timeout, DefaultSourceTimeout)
+	}
+}
+
+func TestCollectFetchesSourcesConcurrently(t *testing.T) {
+	// Each fetch waits for all three to start, so fetching one at a time times out
+	barrier := &sync.WaitGroup{}
+	barrier.Add(3)
+	da := newAggregator(t, time.Second,
+		&stubSource{name: "gas", fields: []string{FieldBaseFee, FieldPriorityFee}, value: 0.1, block: 10, barrier: barrier},
+		&stubSource{name: "eth", fields: []string{FieldETHPrice}, value: 3000.0, block: 11, barrier: barrier},
+		&stubSource{name: "usdc", fields: []string{FieldUSDCPrice}, value: 1.0, block: 12, barrier: barrier},
+	)
+
+	observed, err := da.Collect(context.Background(), FieldBaseFee, FieldPriorityFee, FieldETHPrice, FieldUSDCPrice)
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+
+	// Every field carries the provenance of the source that reported it
+	want := map[string]Provenance{
+		FieldBaseFee:     {Source: "gas", BlockNumber: 10, Timestamp: observedAt},
+		FieldPriorityFee: {Source: "gas", BlockNumber: 10, Timestamp: observedAt},
+		FieldETHPrice:    {Source: "eth", BlockNumber: 11, Timestamp: observedAt},
+		FieldUSDCPrice:   {Source: "usdc", BlockNumber: 12, Timestamp: observedAt},
+	}
+	for field, provenance := range want {
+		if got := observed[field].Provenance; got != provenance {
+			t.Errorf("%s provenance = %+v, want %+v", field, got, provenance)
+		}
+	}
+}
+
+func TestCollectTimesOutSourcesIndependently(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&stubSource{name: "slow", fields: []string{FieldETHPrice}, value: 3000.0, delay: time.Minute}, 20*time.Millisecond)
+	registry.Register(&stubSource{name: "fast", fields: []string{FieldBaseFee}, value: 0.1, delay: 50 * time.Millisecond}, time.Second)
+	da := NewDataAggregator(registry)
+
+	start := time.Now()
+	market, err := da.FetchMarketData(context.Background())
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("FetchMarketData took %s, want the slow source cut off at its timeout", elapsed)
+	}
+
+	var sourceErr *SourceError
+	if !errors.As(err, &sourceErr) || sourceErr.Source != "slow" || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want a deadline *SourceError from slow", err)
+	}
+	// The fast source outlived the slow one's timeout and is still reported
+	if !market.BaseFee.Valid() || market.BaseFee.Value != 0.1 || market.BaseFee.Source != "fast" {
+		t.Errorf("base fee = %+v, want 0.1 from fast", market.BaseFee)
+	}
+	if market.ETHPrice.Valid() {
+		t.Errorf("ETH price = %+v, want unset after the timeout", market.ETHPrice)
+	}
+}
+
+func TestCollectReportsMissingFields(t *testing.T) {
+	da := newAggregator(t, time.Second, &stubSource{name: "gas", fields: []string{FieldBaseFee}, value: 0.1})
+
+	observed, err := da.Collect(context.Background(), FieldBaseFee, FieldETHPrice)
+	if err == nil || !strings.Contains(err.Error(), "no registered source provides "+FieldETHPrice) {
+		t.Errorf("error = %v, want the missing %s", err, FieldETHPrice)
+	}
+	if _, ok := observed[FieldBaseFee]; !ok {
+		t.Error("base fee missing alongside the error")
+	}
+}
+
+func TestFetchPortfolioData(t *testing.T) {
+	portfolio := &Portfolio{
+		BlockNumber: 42,
+		Vault:       VaultState{Decimals: 6},
+		TotalAssets: big.NewInt(2_500_000),
+		Strategies: []StrategyState{
+			{Name: "aave", Allocation: big.NewInt(1_500_000), APY: big.NewInt(450), RiskScore: big.NewInt(20)},
+			{Name: "morpho", Allocation: big.NewInt(1_000_000), APY: big.NewInt(700), RiskScore: big.NewInt(35)},
+		},
+	}
+	da := newAggregator(t, time.Second, &stubSource{name: "controller", fields: []string{FieldPortfolio}, value: portfolio, block: 42})
+
+	data, err := da.FetchPortfolioData(context.Background())
+	if err != nil {
+		t.Fatalf("FetchPortfolioData: %v", err)
+	}
+	if data.TotalAssets.Value != 2.5 || data.TotalAssets.Source != "controller" || data.TotalAssets.BlockNumber != 42 {
+		t.Errorf("total assets = %+v, want 2.5 from controller at block 42", data.TotalAssets)
+	}
+	if got := data.StrategyAllocations["aave"]; got.Value != 1.5 || got.BlockNumber != 42 {
+		t.Errorf("aave allocation = %+v, want 1.5 at block 42", got)
+	}
+	if got := data.APYs["morpho"].Value; got != 0.07 {
+		t.Errorf("morpho APY = %v, want 0.07", got)
+	}
+	if got := data.RiskScores["morpho"].Value; got != 35 {
+		t.Errorf("morpho risk score = %v, want 35", got)
+	}
+}
+
+// feeHistoryStub serves 4 blocks of fee history ending at head
+type feeHistoryStub struct {
+	head uint64
+}
+
+func (s *feeHistoryStub) BlockNumber(ctx context.Context) (uint64, error) {
+	return s.head, nil
+}
+
+func (s *feeHistoryStub) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: number, Time: uint64(observedAt.Unix())}, nil
+}
+
+func (s *feeHistoryStub) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	gwei := func(v int64) *big.Int { return new(big.Int).Mul(big.NewInt(v), big.NewInt(1e9)) }
+	return &ethereum.FeeHistory{
+		OldestBlock: new(big.Int).Sub(lastBlock, big.NewInt(3)),
+		BaseFee:     []*big.Int{gwei(1), gwei(2), gwei(3), gwei(4), gwei(5)},
+		Reward:      [][]*big.Int{{gwei(4)}, {gwei(1)}, {gwei(3)}, {gwei(2)}},
+	}, nil
+}
+
+func TestFeeHistorySource(t *testing.T) {
+	da := newAggregator(t, time.Second, NewFeeHistorySource(&feeHistoryStub{head: 100}))
+
+	market, err := da.FetchMarketData(context.Background())
+	if err != nil {
+		t.Fatalf("FetchMarketData: %v", err)
+	}
+	// The base fee is the next block's; the tip is the median of the sampled blocks
+	if market.BaseFee.Value != 5 || market.PriorityFee.Value != 3 {
+		t.Errorf("fees = %v / %v gwei, want 5 / 3", market.BaseFee.Value, market.PriorityFee.Value)
+	}
+	want := Provenance{Source: "fee_history", BlockNumber: 100, Timestamp: observedAt}
+	if market.BaseFee.Provenance != want || market.PriorityFee.Provenance != want {
+		t.Errorf("provenance = %+v, want %+v", market.BaseFee.Provenance, want)
+	}
+}
@@ -0,0 +1,283 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/aegis-yield/backend/web3-client"
+	"github.com/aegis-yield/backend/web3-client/bindings"
+)
+
+// basisPoints is the denominator of on-chain APY and fee values
+const basisPoints = 10000
+
+// ErrStrategyNotFound is returned when no strategy matches a name or address
+var ErrStrategyNotFound = errors.New("strategy not found")
+
+// ChainCaller is the subset of the RPC backend the portfolio reader needs
+type ChainCaller interface {
+	bind.ContractCaller
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// VaultState is the vault's on-chain state
+type VaultState struct {
+	Address        common.Address
+	TotalAssets    *big.Int // Asset base units
+	TotalSupply    *big.Int // Share base units
+	Decimals       uint8
+	SharePrice     float64  // Assets per share
+	PerformanceFee *big.Int // Basis points
+	ManagementFee  *big.Int // Basis points
+	Paused         bool
+}
+
+// StrategyState is a strategy's on-chain state as seen by the controller
+type StrategyState struct {
+	Address            common.Address
+	Name               string
+	Active             bool
+	Allocation         *big.Int // Controller allocation, asset base units
+	AllocationLimit    *big.Int // Basis points of total assets
+	TotalAssets        *big.Int // Reported by the strategy, asset base units
+	AvailableLiquidity *big.Int // Withdrawable now, asset base units
+	APY                *big.Int // Basis points
+	RiskScore          *big.Int // 0-100
+	Weight             float64  // Share of the controller's total assets
+}
+
+// Portfolio is a consistent snapshot of the vault, controller and strategies read at
+// a single block
+type Portfolio struct {
+	BlockNumber uint64
+	FetchedAt   time.Time
+	Vault       VaultState
+	Controller  common.Address
+	TotalAssets *big.Int // Controller total assets, asset base units
+	IdleAssets  *big.Int // Controller assets not allocated to a strategy
+	Strategies  []StrategyState
+	APY         float64 // Allocation-weighted APY as a fraction; idle assets earn nothing
+	RiskScore   float64 // Allocation-weighted risk score of the allocated assets
+}
+
+// PortfolioReader reads the portfolio from the controller, vault and strategy
+// contracts. Snapshots are cached for a short TTL so frequent polling shares RPC
+// calls; concurrent callers wait for a single refresh.
+type PortfolioReader struct {
+	client     ChainCaller
+	controller common.Address
+	vault      common.Address
+	ttl        time.Duration
+
+	mu     sync.Mutex
+	cached *Portfolio
+}
+
+// NewPortfolioReader creates a reader for the given controller and vault
+func NewPortfolioReader(client ChainCaller, controller, vault common.Address, ttl time.Duration) *PortfolioReader {
+	return &PortfolioReader{
+		client:     client,
+		controller: controller,
+		vault:      vault,
+		ttl:        ttl,
+	}
+}
+
+// Portfolio returns the cached snapshot, refreshing it when older than the TTL
+func (r *PortfolioReader) Portfolio(ctx context.Context) (*Portfolio, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cached != nil && time.Since(r.cached.FetchedAt) < r.ttl {
+		return r.cached, nil
+	}
+
+	portfolio, err := r.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cached = portfolio
+	return portfolio, nil
+}
+
+// Strategy finds a strategy by address or by its on-chain name (case-insensitive)
+func (r *PortfolioReader) Strategy(ctx context.Context, nameOrAddress string) (*StrategyState, error) {
+	portfolio, err := r.Portfolio(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAddress := common.IsHexAddress(nameOrAddress)
+	for i, strategy := range portfolio.Strategies {
+		if isAddress && strategy.Address == common.HexToAddress(nameOrAddress) {
+			return &portfolio.Strategies[i], nil
+		}
+		if strings.EqualFold(strategy.Name, nameOrAddress) {
+			return &portfolio.Strategies[i], nil
+		}
+	}
+
+	return nil, ErrStrategyNotFound
+}
+
+// fetch reads a new snapshot pinned to one block. With several RPC providers it is
+// the lowest healthy head, so every read can be served whichever provider answers it.
+func (r *PortfolioReader) fetch(ctx context.Context) (*Portfolio, error) {
+	var blockNumber uint64
+	var err error
+	if reader, ok := r.client.(web3client.CommonBlockReader); ok {
+		blockNumber, err = reader.CommonBlockNumber(ctx)
+	} else {
+		blockNumber, err = r.client.BlockNumber(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block number: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)}
+
+	vault, err := r.fetchVault(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	controller, err := bindings.NewAegisControllerCaller(r.controller, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind controller: %w", err)
+	}
+
+	totalAssets, err := controller.TotalAssets(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read controller total assets: %w", err)
+	}
+
+	addresses, err := controller.GetStrategies(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read strategies: %w", err)
+	}
+
+	portfolio := &Portfolio{
+		BlockNumber: blockNumber,
+		FetchedAt:   time.Now(),
+		Vault:       *vault,
+		Controller:  r.controller,
+		TotalAssets: totalAssets,
+		IdleAssets:  new(big.Int).Set(totalAssets),
+		Strategies:  make([]StrategyState, 0, len(addresses)),
+	}
+
+	allocated := new(big.Float)
+	var weightedAPY, weightedRisk float64
+	for _, address := range addresses {
+		strategy, err := r.fetchStrategy(opts, controller, address)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, _ := new(big.Float).SetInt(strategy.Allocation).Float64()
+		if totalAssets.Sign() > 0 {
+			total, _ := new(big.Float).SetInt(totalAssets).Float64()
+			strategy.Weight = amount / total
+		}
+		weightedAPY += strategy.Weight * float64(strategy.APY.Uint64()) / basisPoints
+		weightedRisk += amount * float64(strategy.RiskScore.Uint64())
+		allocated.Add(allocated, new(big.Float).SetInt(strategy.Allocation))
+		portfolio.IdleAssets.Sub(portfolio.IdleAssets, strategy.Allocation)
+
+		portfolio.Strategies = append(portfolio.Strategies, *strategy)
+	}
+
+	portfolio.APY = weightedAPY
+	if total, _ := allocated.Float64(); total > 0 {
+		portfolio.RiskScore = weightedRisk / total
+	}
+	if portfolio.IdleAssets.Sign() < 0 {
+		// Strategies can report more than the controller's accounting after yield accrues
+		portfolio.IdleAssets.SetInt64(0)
+	}
+
+	return portfolio, nil
+}
+
+func (r *PortfolioReader) fetchVault(opts *bind.CallOpts) (*VaultState, error) {
+	vault, err := bindings.NewAegisVaultCaller(r.vault, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind vault: %w", err)
+	}
+
+	state := &VaultState{Address: r.vault}
+
+	if state.TotalAssets, err = vault.TotalAssets(opts); err != nil {
+		return nil, fmt.Errorf("failed to read vault total assets: %w", err)
+	}
+	if state.TotalSupply, err = vault.TotalSupply(opts); err != nil {
+		return nil, fmt.Errorf("failed to read vault total supply: %w", err)
+	}
+	if state.Decimals, err = vault.Decimals(opts); err != nil {
+		return nil, fmt.Errorf("failed to read vault decimals: %w", err)
+	}
+	if state.PerformanceFee, err = vault.PerformanceFee(opts); err != nil {
+		return nil, fmt.Errorf("failed to read vault performance fee: %w", err)
+	}
+	if state.ManagementFee, err = vault.ManagementFee(opts); err != nil {
+		return nil, fmt.Errorf("failed to read vault management fee: %w", err)
+	}
+	if state.Paused, err = vault.Paused(opts); err != nil {
+		return nil, fmt.Errorf("failed to read vault paused state: %w", err)
+	}
+
+	oneShare := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(state.Decimals)), nil)
+	assets, err := vault.ConvertToAssets(opts, oneShare)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault share price: %w", err)
+	}
+	state.SharePrice, _ = new(big.Float).Quo(new(big.Float).SetInt(assets), new(big.Float).SetInt(oneShare)).Float64()
+
+	return state, nil
+}
+
+func (r *PortfolioReader) fetchStrategy(opts *bind.CallOpts, controller *bindings.AegisControllerCaller, address common.Address) (*StrategyState, error) {
+	strategy, err := bindings.NewIAegisStrategyCaller(address, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind strategy %s: %w", address.Hex(), err)
+	}
+
+	state := &StrategyState{Address: address}
+
+	if state.Name, err = strategy.Name(opts); err != nil {
+		return nil, fmt.Errorf("failed to read name for strategy %s: %w", address.Hex(), err)
+	}
+	if state.Allocation, err = controller.StrategyAllocation(opts, address); err != nil {
+		return nil, fmt.Errorf("failed to read allocation for strategy %s: %w", address.Hex(), err)
+	}
+
+	config, err := controller.StrategyConfigs(opts, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config for strategy %s: %w", address.Hex(), err)
+	}
+	state.AllocationLimit = config.AllocationLimit
+	state.Active = config.IsActive
+
+	if state.TotalAssets, err = strategy.TotalAssets(opts); err != nil {
+		return nil, fmt.Errorf("failed to read total assets for strategy %s: %w", address.Hex(), err)
+	}
+	if state.AvailableLiquidity, err = strategy.AvailableLiquidity(opts); err != nil {
+		return nil, fmt.Errorf("failed to read liquidity for strategy %s: %w", address.Hex(), err)
+	}
+	if state.APY, err = strategy.CurrentAPY(opts); err != nil {
+		return nil, fmt.Errorf("failed to read APY for strategy %s: %w", address.Hex(), err)
+	}
+	if state.RiskScore, err = strategy.RiskScore(opts); err != nil {
+		return nil, fmt.Errorf("failed to read risk score for strategy %s: %w", address.Hex(), err)
+	}
+
+	return state, nil
+}
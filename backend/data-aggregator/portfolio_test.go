@@ -0,0 +1,332 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/aegis-yield/backend/web3-client/bindings"
+)
+
+var (
+	vaultAddress      = common.HexToAddress("0x1000")
+	controllerAddress = common.HexToAddress("0x2000")
+	aaveAddress       = common.HexToAddress("0x3001")
+	morphoAddress     = common.HexToAddress("0x3002")
+)
+
+// fakeStrategy is a strategy's on-chain state; amounts in whole USDC
+type fakeStrategy struct {
+	name       string
+	allocation int64
+	apy        int64 // Basis points
+	risk       int64
+}
+
+// portfolioChain answers the vault, controller and strategy calls of a 1,000 USDC
+// portfolio with 600 USDC in Aave and 300 in Morpho, recording the block of each call
+type portfolioChain struct {
+	head       uint64
+	strategies map[common.Address]fakeStrategy
+
+	mu        sync.Mutex
+	heads     int // BlockNumber calls
+	failHeads int // BlockNumber calls left to fail
+	blocks    map[uint64]int
+}
+
+func newPortfolioChain(head uint64) *portfolioChain {
+	return &portfolioChain{
+		head: head,
+		strategies: map[common.Address]fakeStrategy{
+			aaveAddress:   {name: "Aave V3", allocation: 600, apy: 500, risk: 20},
+			morphoAddress: {name: "Morpho Blue", allocation: 300, apy: 1000, risk: 50},
+		},
+		blocks: make(map[uint64]int),
+	}
+}
+
+func usdc(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(1_000_000))
+}
+
+func (c *portfolioChain) BlockNumber(ctx context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.heads++
+	if c.failHeads > 0 {
+		c.failHeads--
+		return 0, errors.New("connection refused")
+	}
+	return c.head, nil
+}
+
+func (c *portfolioChain) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *portfolioChain) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	c.mu.Lock()
+	c.blocks[blockNumber.Uint64()]++
+	c.mu.Unlock()
+
+	metadata := bindings.IAegisStrategyMetaData
+	switch *call.To {
+	case vaultAddress:
+		metadata = bindings.AegisVaultMetaData
+	case controllerAddress:
+		metadata = bindings.AegisControllerMetaData
+	}
+	contract, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := contract.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := c.answer(*call.To, method.Name, args)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(outputs...)
+}
+
+func (c *portfolioChain) answer(to common.Address, method string, args []interface{}) ([]interface{}, error) {
+	switch to {
+	case vaultAddress:
+		switch method {
+		case "totalAssets":
+			return []interface{}{usdc(1000)}, nil
+		case "totalSupply":
+			return []interface{}{usdc(800)}, nil
+		case "decimals":
+			return []interface{}{uint8(6)}, nil
+		case "performanceFee":
+			return []interface{}{big.NewInt(1000)}, nil
+		case "managementFee":
+			return []interface{}{big.NewInt(200)}, nil
+		case "paused":
+			return []interface{}{false}, nil
+		case "convertToAssets":
+			shares := args[0].(*big.Int)
+			return []interface{}{new(big.Int).Div(new(big.Int).Mul(shares, big.NewInt(5)), big.NewInt(4))}, nil
+		}
+	case controllerAddress:
+		switch method {
+		case "totalAssets":
+			return []interface{}{usdc(1000)}, nil
+		case "getStrategies":
+			return []interface{}{[]common.Address{aaveAddress, morphoAddress}}, nil
+		case "strategyAllocation":
+			return []interface{}{usdc(c.strategies[args[0].(common.Address)].allocation)}, nil
+		case "strategyConfigs":
+			strategy := c.strategies[args[0].(common.Address)]
+			return []interface{}{big.NewInt(7000), usdc(strategy.allocation), true, big.NewInt(1)}, nil
+		}
+	default:
+		strategy, ok := c.strategies[to]
+		if !ok {
+			break
+		}
+		switch method {
+		case "name":
+			return []interface{}{strategy.name}, nil
+		case "totalAssets", "availableLiquidity":
+			return []interface{}{usdc(strategy.allocation)}, nil
+		case "currentAPY":
+			return []interface{}{big.NewInt(strategy.apy)}, nil
+		case "riskScore":
+			return []interface{}{big.NewInt(strategy.risk)}, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected call %s on %s", method, to.Hex())
+}
+
+// readBlocks returns the blocks read at and the number of calls at each
+func (c *portfolioChain) readBlocks() map[uint64]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	blocks := make(map[uint64]int, len(c.blocks))
+	for block, calls := range c.blocks {
+		blocks[block] = calls
+	}
+	return blocks
+}
+
+// lowestHealthyHead is a multi-provider chain whose lowest healthy head trails the latest
+type lowestHealthyHead struct {
+	*portfolioChain
+	common uint64
+}
+
+func (c *lowestHealthyHead) CommonBlockNumber(ctx context.Context) (uint64, error) {
+	return c.common, nil
+}
+
+func TestPortfolioReaderReadsOneBlock(t *testing.T) {
+	tests := []struct {
+		name   string
+		client func(chain *portfolioChain) ChainCaller
+		block  uint64
+	}{
+		{"single provider", func(chain *portfolioChain) ChainCaller { return chain }, 105},
+		{"lowest healthy head", func(chain *portfolioChain) ChainCaller { return &lowestHealthyHead{chain, 100} }, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newPortfolioChain(105)
+			reader := NewPortfolioReader(tt.client(chain), controllerAddress, vaultAddress, time.Minute)
+
+			portfolio, err := reader.Portfolio(context.Background())
+			if err != nil {
+				t.Fatalf("Portfolio: %v", err)
+			}
+			if portfolio.BlockNumber != tt.block {
+				t.Errorf("block = %d, want %d", portfolio.BlockNumber, tt.block)
+			}
+			if blocks := chain.readBlocks(); len(blocks) != 1 || blocks[tt.block] == 0 {
+				t.Errorf("calls by block = %v, want every call at block %d", blocks, tt.block)
+			}
+		})
+	}
+}
+
+func TestPortfolioReaderSnapshot(t *testing.T) {
+	reader := NewPortfolioReader(newPortfolioChain(100), controllerAddress, vaultAddress, time.Minute)
+
+	portfolio, err := reader.Portfolio(context.Background())
+	if err != nil {
+		t.Fatalf("Portfolio: %v", err)
+	}
+
+	if portfolio.Vault.SharePrice != 1.25 || portfolio.Vault.Decimals != 6 || portfolio.Vault.TotalSupply.Cmp(usdc(800)) != 0 {
+		t.Errorf("vault = %+v, want 800 shares at 1.25 USDC", portfolio.Vault)
+	}
+	if portfolio.TotalAssets.Cmp(usdc(1000)) != 0 || portfolio.IdleAssets.Cmp(usdc(100)) != 0 {
+		t.Errorf("assets = %s total, %s idle; want 1000 and 100 USDC", portfolio.TotalAssets, portfolio.IdleAssets)
+	}
+	if len(portfolio.Strategies) != 2 || portfolio.Strategies[0].Weight != 0.6 || portfolio.Strategies[1].Weight != 0.3 {
+		t.Errorf("strategies = %+v, want Aave at 0.6 and Morpho at 0.3", portfolio.Strategies)
+	}
+	// 0.6 × 5% + 0.3 × 10%, idle assets earning nothing
+	if portfolio.APY < 0.05999 || portfolio.APY > 0.06001 {
+		t.Errorf("APY = %v, want 0.06", portfolio.APY)
+	}
+	// (600 × 20 + 300 × 50) / 900
+	if portfolio.RiskScore != 30 {
+		t.Errorf("risk score = %v, want 30", portfolio.RiskScore)
+	}
+}
+
+func TestPortfolioReaderCache(t *testing.T) {
+	t.Run("shares reads within the TTL", func(t *testing.T) {
+		chain := newPortfolioChain(100)
+		reader := NewPortfolioReader(chain, controllerAddress, vaultAddress, time.Hour)
+
+		first, err := reader.Portfolio(context.Background())
+		if err != nil {
+			t.Fatalf("Portfolio: %v", err)
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if portfolio, err := reader.Portfolio(context.Background()); err != nil || portfolio != first {
+					t.Errorf("Portfolio = %p, %v; want the cached snapshot %p", portfolio, err, first)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if chain.heads != 1 {
+			t.Errorf("read the chain %d times, want once", chain.heads)
+		}
+	})
+
+	t.Run("refreshes once expired", func(t *testing.T) {
+		chain := newPortfolioChain(100)
+		reader := NewPortfolioReader(chain, controllerAddress, vaultAddress, 20*time.Millisecond)
+
+		first, err := reader.Portfolio(context.Background())
+		if err != nil {
+			t.Fatalf("Portfolio: %v", err)
+		}
+		time.Sleep(30 * time.Millisecond)
+		chain.head = 101
+
+		second, err := reader.Portfolio(context.Background())
+		if err != nil {
+			t.Fatalf("Portfolio: %v", err)
+		}
+		if second == first || second.BlockNumber != 101 || chain.heads != 2 {
+			t.Errorf("snapshot at block %d after %d reads, want a fresh read at block 101", second.BlockNumber, chain.heads)
+		}
+	})
+
+	t.Run("does not cache failures", func(t *testing.T) {
+		chain := newPortfolioChain(100)
+		chain.failHeads = 1
+		reader := NewPortfolioReader(chain, controllerAddress, vaultAddress, time.Hour)
+
+		if _, err := reader.Portfolio(context.Background()); err == nil {
+			t.Fatal("Portfolio succeeded with the RPC down")
+		}
+		if _, err := reader.Portfolio(context.Background()); err != nil {
+			t.Fatalf("Portfolio after recovery: %v", err)
+		}
+	})
+}
+
+func TestPortfolioReaderStrategy(t *testing.T) {
+	reader := NewPortfolioReader(newPortfolioChain(100), controllerAddress, vaultAddress, time.Hour)
+
+	tests := []struct {
+		name          string
+		nameOrAddress string
+		want          common.Address
+		err           error
+	}{
+		{"name", "Aave V3", aaveAddress, nil},
+		{"name in other case", "MORPHO blue", morphoAddress, nil},
+		{"checksummed address", aaveAddress.Hex(), aaveAddress, nil},
+		{"lowercase address", strings.ToLower(morphoAddress.Hex()), morphoAddress, nil},
+		{"unknown name", "Compound", common.Address{}, ErrStrategyNotFound},
+		{"unknown address", common.HexToAddress("0x3003").Hex(), common.Address{}, ErrStrategyNotFound},
+		{"partial name", "Aave", common.Address{}, ErrStrategyNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := reader.Strategy(context.Background(), tt.nameOrAddress)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Strategy(%q) = %v, %v; want %v", tt.nameOrAddress, strategy, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Strategy(%q): %v", tt.nameOrAddress, err)
+			}
+			if strategy.Address != tt.want {
+				t.Errorf("Strategy(%q) = %s, want %s", tt.nameOrAddress, strategy.Address.Hex(), tt.want.Hex())
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ControllerSource reports the portfolio read from the controller, vault and strategies
+type ControllerSource struct {
+	reader *PortfolioReader
+}
+
+// NewControllerSource creates a portfolio source backed by reader
+func NewControllerSource(reader *PortfolioReader) *ControllerSource {
+	return &ControllerSource{reader: reader}
+}
+
+// Name implements Source
+func (s *ControllerSource) Name() string {
+	return "controller"
+}
+
+// Provides implements Source
+func (s *ControllerSource) Provides() []string {
+	return []string{FieldPortfolio}
+}
+
+// Fetch implements Source
+func (s *ControllerSource) Fetch(ctx context.Context) ([]Observation, error) {
+	portfolio, err := s.reader.Portfolio(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Observation{{
+		Field: FieldPortfolio,
+		Value: portfolio,
+		Provenance: Provenance{
+			Source:      s.Name(),
+			BlockNumber: portfolio.BlockNumber,
+			Timestamp:   portfolio.FetchedAt,
+		},
+	}}, nil
+}
+
+// aggregatorV3ABI is the subset of Chainlink's AggregatorV3Interface used by the backend
+const aggregatorV3ABI = `[
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"latestRoundData","stateMutability":"view","inputs":[],"outputs":[
+		{"name":"roundId","type":"uint80"},
+		{"name":"answer","type":"int256"},
+		{"name":"startedAt","type":"uint256"},
+		{"name":"updatedAt","type":"uint256"},
+		{"name":"answeredInRound","type":"uint80"}
+	]}
+]`
+
+var aggregatorV3 = mustParseABI(aggregatorV3ABI)
+
+// Round is a Chainlink aggregator round
+type Round struct {
+	RoundID         *big.Int
+	Answer          *big.Int // Scaled by the feed's decimals
+	StartedAt       time.Time
+	UpdatedAt       time.Time
+	AnsweredInRound *big.Int
+}
+
+// ChainlinkSource reports a price from a Chainlink AggregatorV3 feed. Rounds that fail
+// the feed's checks are rejected with an *UnsafeDataError.
+type ChainlinkSource struct {
+	client ChainCaller
+	feed   *bind.BoundContract
+	name   string
+	field  string
+	guard  *roundGuard
+
+	mu       sync.Mutex
+	decimals *uint8
+}
+
+// NewChainlinkSource creates a source reporting field from the feed at address
+func NewChainlinkSource(client ChainCaller, name, field string, address common.Address, checks FeedChecks) *ChainlinkSource {
+	return &ChainlinkSource{
+		client: client,
+		feed:   bind.NewBoundContract(address, aggregatorV3, client, nil, nil),
+		name:   name,
+		field:  field,
+		guard:  newRoundGuard(name, checks),
+	}
+}
+
+// Name implements Source
+func (s *ChainlinkSource) Name() string {
+	return s.name
+}
+
+// Provides implements Source
+func (s *ChainlinkSource) Provides() []string {
+	return []string{s.field}
+}
+
+// Fetch implements Source. The provenance timestamp is the round's updatedAt, not the
+// time of the read.
+func (s *ChainlinkSource) Fetch(ctx context.Context) ([]Observation, error) {
+	blockNumber, err := s.client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block number: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)}
+
+	decimals, err := s.feedDecimals(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	round, err := latestRound(s.feed, opts)
+	if err != nil {
+		return nil, err
+	}
+	if round.Answer.Sign() <= 0 {
+		return nil, fmt.Errorf("feed returned non-positive answer %s in round %s", round.Answer, round.RoundID)
+	}
+
+	price := toUnits(round.Answer, decimals)
+	if err := s.guard.check(round, price, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return []Observation{{
+		Field: s.field,
+		Value: price,
+		Provenance: Provenance{
+			Source:      s.name,
+			BlockNumber: blockNumber,
+			Timestamp:   round.UpdatedAt,
+		},
+	}}, nil
+}
+
+// feedDecimals reads the feed's decimals once; they are fixed for an aggregator proxy
+func (s *ChainlinkSource) feedDecimals(opts *bind.CallOpts) (uint8, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.decimals != nil {
+		return *s.decimals, nil
+	}
+
+	var out []interface{}
+	if err := s.feed.Call(opts, &out, "decimals"); err != nil {
+		return 0, fmt.Errorf("failed to read feed decimals: %w", err)
+	}
+	decimals := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	s.decimals = &decimals
+	return decimals, nil
+}
+
+func latestRound(feed *bind.BoundContract, opts *bind.CallOpts) (*Round, error) {
+	var out []interface{}
+	if err := feed.Call(opts, &out, "latestRoundData"); err != nil {
+		return nil, fmt.Errorf("failed to read latest round: %w", err)
+	}
+
+	return &Round{
+		RoundID:         *abi.ConvertType(out[0], new(*big.Int)).(**big.Int),
+		Answer:          *abi.ConvertType(out[1], new(*big.Int)).(**big.Int),
+		StartedAt:       unixTime(*abi.ConvertType(out[2], new(*big.Int)).(**big.Int)),
+		UpdatedAt:       unixTime(*abi.ConvertType(out[3], new(*big.Int)).(**big.Int)),
+		AnsweredInRound: *abi.ConvertType(out[4], new(*big.Int)).(**big.Int),
+	}, nil
+}
+
+// SequencerUptimeSource reports how long the L2 sequencer has been up, from
+// Chainlink's sequencer uptime feed. It rejects reads with an *UnsafeDataError while
+// the sequencer is down and for a grace period after it comes back, when prices and
+// positions may not yet reflect transactions queued on L1.
+type SequencerUptimeSource struct {
+	client      ChainCaller
+	feed        *bind.BoundContract
+	gracePeriod time.Duration
+}
+
+// NewSequencerUptimeSource creates a source for the uptime feed at address
+func NewSequencerUptimeSource(client ChainCaller, address common.Address, gracePeriod time.Duration) *SequencerUptimeSource {
+	return &SequencerUptimeSource{
+		client:      client,
+		feed:        bind.NewBoundContract(address, aggregatorV3, client, nil, nil),
+		gracePeriod: gracePeriod,
+	}
+}
+
+// Name implements Source
+func (s *SequencerUptimeSource) Name() string {
+	return "sequencer_uptime"
+}
+
+// Provides implements Source
+func (s *SequencerUptimeSource) Provides() []string {
+	return []string{FieldSequencerUptime}
+}
+
+// Fetch implements Source. The provenance timestamp is when the sequencer last came up.
+func (s *SequencerUptimeSource) Fetch(ctx context.Context) ([]Observation, error) {
+	blockNumber, err := s.client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block number: %w", err)
+	}
+
+	round, err := latestRound(s.feed, &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)})
+	if err != nil {
+		return nil, err
+	}
+
+	// The answer is 0 while the sequencer is up and 1 while it is down; startedAt is
+	// when the status last changed
+	if round.Answer.Sign() != 0 {
+		return nil, &UnsafeDataError{Source: s.Name(), Reason: ReasonSequencerDown, Detail: fmt.Sprintf("sequencer down since %s", round.StartedAt.Format(time.RFC3339))}
+	}
+	if round.StartedAt.Unix() == 0 {
+		return nil, fmt.Errorf("uptime feed round %s has not started", round.RoundID)
+	}
+	uptime := time.Since(round.StartedAt)
+	if uptime < s.gracePeriod {
+		return nil, &UnsafeDataError{Source: s.Name(), Reason: ReasonSequencerGracePeriod, Detail: fmt.Sprintf("sequencer up for %s, grace period is %s", uptime.Truncate(time.Second), s.gracePeriod)}
+	}
+
+	return []Observation{{
+		Field: FieldSequencerUptime,
+		Value: uptime,
+		Provenance: Provenance{
+			Source:      s.Name(),
+			BlockNumber: blockNumber,
+			Timestamp:   round.StartedAt,
+		},
+	}}, nil
+}
+
+// FeeHistoryClient is the subset of the RPC backend the fee history source needs
+type FeeHistoryClient interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// feeHistoryBlocks is the number of recent blocks the fee history source samples
+const feeHistoryBlocks = 20
+
+// FeeHistorySource reports the next block's base fee and the median priority fee paid
+// over recent blocks, from eth_feeHistory
+type FeeHistorySource struct {
+	client FeeHistoryClient
+}
+
+// NewFeeHistorySource creates a gas source backed by client
+func NewFeeHistorySource(client FeeHistoryClient) *FeeHistorySource {
+	return &FeeHistorySource{client: client}
+}
+
+// Name implements Source
+func (s *FeeHistorySource) Name() string {
+	return "fee_history"
+}
+
+// Provides implements Source
+func (s *FeeHistorySource) Provides() []string {
+	return []string{FieldBaseFee, FieldPriorityFee}
+}
+
+// Fetch implements Source
+func (s *FeeHistorySource) Fetch(ctx context.Context) ([]Observation, error) {
+	blockNumber, err := s.client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block number: %w", err)
+	}
+	latest := new(big.Int).SetUint64(blockNumber)
+
+	history, err := s.client.FeeHistory(ctx, feeHistoryBlocks, latest, []float64{50})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee history: %w", err)
+	}
+	if len(history.BaseFee) == 0 {
+		return nil, fmt.Errorf("fee history returned no base fees")
+	}
+
+	header, err := s.client.HeaderByNumber(ctx, latest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block %d: %w", blockNumber, err)
+	}
+
+	// BaseFee has one more entry than the sampled blocks: the fee of the next block
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	tips := make([]*big.Int, 0, len(history.Reward))
+	for _, rewards := range history.Reward {
+		if len(rewards) > 0 && rewards[0] != nil {
+			tips = append(tips, rewards[0])
+		}
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	priorityFee := new(big.Int)
+	if len(tips) > 0 {
+		priorityFee = tips[len(tips)/2]
+	}
+
+	provenance := Provenance{
+		Source:      s.Name(),
+		BlockNumber: blockNumber,
+		Timestamp:   time.Unix(int64(header.Time), 0).UTC(),
+	}
+	return []Observation{
+		{Field: FieldBaseFee, Value: toUnits(baseFee, 9), Provenance: provenance},
+		{Field: FieldPriorityFee, Value: toUnits(priorityFee, 9), Provenance: provenance},
+	}, nil
+}
+
+// toUnits converts a fixed-point integer with the given decimals to a float
+func toUnits(value *big.Int, decimals uint8) float64 {
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	units, _ := new(big.Float).Quo(new(big.Float).SetInt(value), scale).Float64()
+	return units
+}
+
+func unixTime(seconds *big.Int) time.Time {
+	return time.Unix(seconds.Int64(), 0).UTC()
+}
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(fmt.Sprintf("invalid contract ABI: %v", err))
+	}
+	return parsed
+}
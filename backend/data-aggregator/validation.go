@@ -0,0 +1,108 @@
+package aggregator
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// UnsafeReason classifies why a source rejected its data
+type UnsafeReason string
+
+const (
+	ReasonStaleRound           UnsafeReason = "stale_round"
+	ReasonIncompleteRound      UnsafeReason = "incomplete_round"
+	ReasonPriceDeviation       UnsafeReason = "price_deviation"
+	ReasonSequencerDown        UnsafeReason = "sequencer_down"
+	ReasonSequencerGracePeriod UnsafeReason = "sequencer_grace_period"
+)
+
+// UnsafeDataError reports data that was read successfully but must not be acted on
+type UnsafeDataError struct {
+	Source string
+	Reason UnsafeReason
+	Detail string
+}
+
+func (e *UnsafeDataError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Reason, e.Detail)
+}
+
+// FeedChecks are the checks applied to every round read from a price feed. Zero
+// values disable a check.
+type FeedChecks struct {
+	Heartbeat    time.Duration // Maximum age of the latest round
+	MaxDeviation float64       // Maximum relative price move since the last accepted round
+}
+
+// acceptedRound is a round that passed, or is waiting to pass, the deviation check
+type acceptedRound struct {
+	roundID *big.Int
+	price   float64
+}
+
+// roundGuard applies FeedChecks to the rounds of one feed. A price move beyond
+// MaxDeviation is rejected until a later round confirms it by staying within
+// MaxDeviation of the rejected price, so a genuine market move holds off the keeper
+// for a single feed update rather than indefinitely.
+type roundGuard struct {
+	source string
+	checks FeedChecks
+
+	mu       sync.Mutex
+	accepted *acceptedRound
+	pending  *acceptedRound
+}
+
+func newRoundGuard(source string, checks FeedChecks) *roundGuard {
+	return &roundGuard{source: source, checks: checks}
+}
+
+// check validates a round and its decimal price, recording it as the last accepted
+// round when it passes
+func (g *roundGuard) check(round *Round, price float64, now time.Time) error {
+	if round.UpdatedAt.Unix() == 0 {
+		return g.unsafe(ReasonIncompleteRound, "round %s has not been updated", round.RoundID)
+	}
+	if round.AnsweredInRound.Cmp(round.RoundID) < 0 {
+		return g.unsafe(ReasonIncompleteRound, "round %s was answered in earlier round %s", round.RoundID, round.AnsweredInRound)
+	}
+	if age := now.Sub(round.UpdatedAt); g.checks.Heartbeat > 0 && age > g.checks.Heartbeat {
+		return g.unsafe(ReasonStaleRound, "round %s is %s old, heartbeat is %s", round.RoundID, age.Truncate(time.Second), g.checks.Heartbeat)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	candidate := &acceptedRound{roundID: round.RoundID, price: price}
+	if g.accepted == nil || g.checks.MaxDeviation <= 0 || g.accepted.roundID.Cmp(round.RoundID) == 0 {
+		g.accepted, g.pending = candidate, nil
+		return nil
+	}
+
+	deviation := relativeChange(g.accepted.price, price)
+	if deviation <= g.checks.MaxDeviation {
+		g.accepted, g.pending = candidate, nil
+		return nil
+	}
+	if g.pending != nil && g.pending.roundID.Cmp(round.RoundID) != 0 && relativeChange(g.pending.price, price) <= g.checks.MaxDeviation {
+		g.accepted, g.pending = candidate, nil
+		return nil
+	}
+
+	if g.pending == nil || g.pending.roundID.Cmp(round.RoundID) != 0 {
+		g.pending = candidate
+	}
+	return g.unsafe(ReasonPriceDeviation, "price moved %.2f%% from %g to %g in round %s, limit is %.2f%%",
+		deviation*100, g.accepted.price, price, round.RoundID, g.checks.MaxDeviation*100)
+}
+
+func (g *roundGuard) unsafe(reason UnsafeReason, format string, args ...interface{}) error {
+	return &UnsafeDataError{Source: g.source, Reason: reason, Detail: fmt.Sprintf(format, args...)}
+}
+
+func relativeChange(from, to float64) float64 {
+	return math.Abs(to-from) / from
+}
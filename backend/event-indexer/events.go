@@ -0,0 +1,179 @@
+package indexer
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/aegis-yield/backend/web3-client/bindings"
+)
+
+// Contract names stored with each event
+const (
+	ContractController = "controller"
+	ContractVault      = "vault"
+)
+
+// ControllerEvents are the AegisController events the indexer stores
+var ControllerEvents = []string{"Rebalanced", "StrategyAdded", "StrategyRemoved", "StrategyHarvested", "EmergencyWithdraw"}
+
+// VaultEvents are the AegisVault events the indexer stores
+var VaultEvents = []string{"Deposit", "Withdraw", "FeesCollected", "PerformanceFeeUpdated", "ManagementFeeUpdated"}
+
+// Event is a decoded contract event
+type Event struct {
+	Contract    string            `json:"contract"`
+	Address     common.Address    `json:"address"`
+	Name        string            `json:"name"`
+	BlockNumber uint64            `json:"blockNumber"`
+	BlockHash   common.Hash       `json:"blockHash"`
+	BlockTime   time.Time         `json:"blockTime"`
+	TxHash      common.Hash       `json:"txHash"`
+	TxIndex     uint              `json:"txIndex"`
+	LogIndex    uint              `json:"logIndex"`
+	Args        map[string]string `json:"args"` // Integers as decimal strings, addresses and hashes as hex
+}
+
+// trackedContract is a contract whose events are indexed
+type trackedContract struct {
+	name    string
+	address common.Address
+	abi     abi.ABI
+	events  map[common.Hash]abi.Event
+}
+
+// decoder turns raw logs from the tracked contracts into events
+type decoder struct {
+	contracts map[common.Address]*trackedContract
+	topics    []common.Hash
+}
+
+func newDecoder(controller, vault common.Address) (*decoder, error) {
+	d := &decoder{contracts: make(map[common.Address]*trackedContract)}
+
+	if err := d.track(ContractController, controller, bindings.AegisControllerMetaData, ControllerEvents); err != nil {
+		return nil, err
+	}
+	if err := d.track(ContractVault, vault, bindings.AegisVaultMetaData, VaultEvents); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (d *decoder) track(name string, address common.Address, metadata *bind.MetaData, events []string) error {
+	if address == (common.Address{}) {
+		return fmt.Errorf("%s address is not set", name)
+	}
+
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return fmt.Errorf("failed to parse %s ABI: %w", name, err)
+	}
+
+	contract := &trackedContract{
+		name:    name,
+		address: address,
+		abi:     *parsed,
+		events:  make(map[common.Hash]abi.Event),
+	}
+	for _, eventName := range events {
+		event, ok := parsed.Events[eventName]
+		if !ok {
+			return fmt.Errorf("%s ABI has no %s event", name, eventName)
+		}
+		contract.events[event.ID] = event
+		d.topics = append(d.topics, event.ID)
+	}
+
+	d.contracts[address] = contract
+	return nil
+}
+
+// query returns the log filter for a block range
+func (d *decoder) query(from, to uint64) ethereum.FilterQuery {
+	addresses := make([]common.Address, 0, len(d.contracts))
+	for address := range d.contracts {
+		addresses = append(addresses, address)
+	}
+
+	return ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: addresses,
+		Topics:    [][]common.Hash{d.topics},
+	}
+}
+
+// decode decodes a log. It returns nil for logs from untracked contracts or events.
+func (d *decoder) decode(log types.Log, blockTime time.Time) (*Event, error) {
+	contract, ok := d.contracts[log.Address]
+	if !ok || len(log.Topics) == 0 {
+		return nil, nil
+	}
+	event, ok := contract.events[log.Topics[0]]
+	if !ok {
+		return nil, nil
+	}
+
+	values := make(map[string]interface{})
+	if len(log.Data) > 0 {
+		if err := contract.abi.UnpackIntoMap(values, event.Name, log.Data); err != nil {
+			return nil, fmt.Errorf("failed to decode %s data in tx %s: %w", event.Name, log.TxHash.Hex(), err)
+		}
+	}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, log.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("failed to decode %s topics in tx %s: %w", event.Name, log.TxHash.Hex(), err)
+	}
+
+	args := make(map[string]string, len(values))
+	for key, value := range values {
+		args[key] = formatValue(value)
+	}
+
+	return &Event{
+		Contract:    contract.name,
+		Address:     log.Address,
+		Name:        event.Name,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		BlockTime:   blockTime,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		Args:        args,
+	}, nil
+}
+
+// formatValue renders a decoded ABI value as a string
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case [32]byte:
+		return common.Hash(v).Hex()
+	case []byte:
+		return "0x" + common.Bytes2Hex(v)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,310 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aegis-yield/backend/web3-client"
+)
+
+// ChainReader is the subset of web3client.MultiClient the indexer needs
+type ChainReader interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	BlockRef(ctx context.Context, number uint64) (*web3client.BlockRef, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// Config controls backfill and head following
+type Config struct {
+	StartBlock    uint64        // First block to index, normally the contracts' deployment block
+	ChunkSize     uint64        // Maximum blocks per eth_getLogs request
+	Confirmations uint64        // Blocks to stay behind the chain head
+	PollInterval  time.Duration // Wait between head checks once caught up
+	ReorgDepth    uint64        // Deepest reorg handled by walking back checkpoints
+}
+
+// DefaultConfig returns the default indexer configuration
+func DefaultConfig() Config {
+	return Config{
+		ChunkSize:     2000,
+		Confirmations: 2,
+		PollInterval:  2 * time.Second,
+		ReorgDepth:    64,
+	}
+}
+
+// chunkGrowthAfter is the number of successful eth_getLogs calls before a reduced
+// chunk size is doubled again
+const chunkGrowthAfter = 20
+
+// ReorgError reports that indexed blocks are no longer on the canonical chain
+type ReorgError struct {
+	Block    uint64
+	Stored   common.Hash
+	Observed common.Hash
+}
+
+func (e *ReorgError) Error() string {
+	return fmt.Sprintf("reorg at block %d: indexed %s, chain has %s", e.Block, e.Stored.Hex(), e.Observed.Hex())
+}
+
+// Indexer backfills and follows controller and vault events into a Store
+type Indexer struct {
+	client  ChainReader
+	store   *Store
+	decoder *decoder
+	config  Config
+	chunk   uint64
+	// successes counts eth_getLogs calls since the chunk size was last changed
+	successes int
+	logger    *logrus.Logger
+}
+
+// NewIndexer creates an indexer for the given controller and vault
+func NewIndexer(client ChainReader, store *Store, controller, vault common.Address, config Config, logger *logrus.Logger) (*Indexer, error) {
+	if config.ChunkSize == 0 {
+		return nil, fmt.Errorf("chunk size must be at least 1")
+	}
+
+	decoder, err := newDecoder(controller, vault)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Indexer{
+		client:  client,
+		store:   store,
+		decoder: decoder,
+		config:  config,
+		chunk:   config.ChunkSize,
+		logger:  logger,
+	}, nil
+}
+
+// Run indexes until the context is cancelled. Backfill proceeds chunk by chunk without
+// waiting; once caught up the chain head is polled every PollInterval.
+func (ix *Indexer) Run(ctx context.Context) error {
+	ix.logger.WithFields(logrus.Fields{
+		"startBlock":    ix.config.StartBlock,
+		"chunkSize":     ix.config.ChunkSize,
+		"confirmations": ix.config.Confirmations,
+	}).Info("Event indexer started")
+
+	for {
+		caughtUp, err := ix.Sync(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			ix.logger.WithError(err).Warn("Indexing step failed, retrying")
+		}
+
+		if caughtUp || err != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(ix.config.PollInterval):
+			}
+		} else if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// Sync indexes the next chunk of blocks, rolling back first if the chain reorganised
+// under the stored head. It reports whether the indexer has caught up with the chain.
+func (ix *Indexer) Sync(ctx context.Context) (bool, error) {
+	latest, err := ix.client.BlockNumber(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get block number: %w", err)
+	}
+	if latest < ix.config.Confirmations {
+		return true, nil
+	}
+	target := latest - ix.config.Confirmations
+
+	head, err := ix.store.Head(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	from := ix.config.StartBlock
+	if head != nil {
+		hash, err := ix.blockHash(ctx, head.Number)
+		if err != nil {
+			return false, err
+		}
+		if hash != head.Hash {
+			return false, ix.handleReorg(ctx, &ReorgError{Block: head.Number, Stored: head.Hash, Observed: hash})
+		}
+		from = head.Number + 1
+	}
+	if from > target {
+		return true, nil
+	}
+
+	to := from + ix.chunk - 1
+	if to > target {
+		to = target
+	}
+
+	if err := ix.indexRange(ctx, from, to); err != nil {
+		return false, err
+	}
+
+	return to == target, nil
+}
+
+// indexRange fetches, decodes and stores the events in [from, to]
+func (ix *Indexer) indexRange(ctx context.Context, from, to uint64) error {
+	toHash, err := ix.blockHash(ctx, to)
+	if err != nil {
+		return err
+	}
+
+	logs, err := ix.client.FilterLogs(ctx, ix.decoder.query(from, to))
+	if err != nil {
+		// Providers cap eth_getLogs by range and result count; retry with a smaller range
+		if ix.chunk > 1 {
+			ix.chunk /= 2
+			ix.successes = 0
+			ix.logger.WithFields(logrus.Fields{
+				"from":  from,
+				"to":    to,
+				"chunk": ix.chunk,
+			}).Warn("eth_getLogs failed, reducing chunk size")
+		}
+		return fmt.Errorf("failed to get logs for blocks %d-%d: %w", from, to, err)
+	}
+	// Grow back slowly so a provider limit is not hit on every other request
+	ix.successes++
+	if ix.chunk < ix.config.ChunkSize && ix.successes >= chunkGrowthAfter {
+		ix.chunk = min(ix.chunk*2, ix.config.ChunkSize)
+		ix.successes = 0
+	}
+
+	checkpoints := map[uint64]common.Hash{to: toHash}
+	blockTimes := make(map[uint64]time.Time)
+	var events []Event
+
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+
+		blockTime, ok := blockTimes[log.BlockNumber]
+		if !ok {
+			block, err := ix.block(ctx, log.BlockNumber)
+			if err != nil {
+				return err
+			}
+			if block.Hash != log.BlockHash {
+				return &ReorgError{Block: log.BlockNumber, Stored: log.BlockHash, Observed: block.Hash}
+			}
+			blockTime = time.Unix(int64(block.Time), 0).UTC()
+			blockTimes[log.BlockNumber] = blockTime
+			checkpoints[log.BlockNumber] = log.BlockHash
+		}
+
+		event, err := ix.decoder.decode(log, blockTime)
+		if err != nil {
+			return err
+		}
+		if event != nil {
+			events = append(events, *event)
+		}
+	}
+
+	// A reorg while the logs were fetched would leave them inconsistent with toHash
+	if hash, err := ix.blockHash(ctx, to); err != nil {
+		return err
+	} else if hash != toHash {
+		return &ReorgError{Block: to, Stored: toHash, Observed: hash}
+	}
+
+	saved := make([]Checkpoint, 0, len(checkpoints))
+	for number, hash := range checkpoints {
+		saved = append(saved, Checkpoint{Number: number, Hash: hash})
+	}
+	var pruneBelow uint64
+	if to > ix.config.ReorgDepth {
+		pruneBelow = to - ix.config.ReorgDepth
+	}
+
+	if err := ix.store.SaveRange(ctx, events, saved, pruneBelow); err != nil {
+		return err
+	}
+
+	ix.logger.WithFields(logrus.Fields{
+		"from":   from,
+		"to":     to,
+		"events": len(events),
+	}).Debug("Indexed block range")
+
+	return nil
+}
+
+// handleReorg rolls the store back to the newest checkpoint still on the canonical
+// chain, or to ReorgDepth blocks below the stored head when none is found
+func (ix *Indexer) handleReorg(ctx context.Context, reorg *ReorgError) error {
+	ix.logger.WithFields(logrus.Fields{
+		"block":    reorg.Block,
+		"stored":   reorg.Stored.Hex(),
+		"observed": reorg.Observed.Hex(),
+	}).Warn("Chain reorganisation detected, rolling back")
+
+	checkpoints, err := ix.store.Checkpoints(ctx, int(ix.config.ReorgDepth)+1)
+	if err != nil {
+		return err
+	}
+
+	for _, checkpoint := range checkpoints {
+		if reorg.Block-checkpoint.Number > ix.config.ReorgDepth {
+			break
+		}
+		hash, err := ix.blockHash(ctx, checkpoint.Number)
+		if err != nil {
+			return err
+		}
+		if hash == checkpoint.Hash {
+			ix.logger.WithField("block", checkpoint.Number).Info("Rolled back to common ancestor")
+			return ix.store.Rollback(ctx, &checkpoint)
+		}
+	}
+
+	// No common ancestor within the reorg depth: restart from the last block assumed safe
+	if reorg.Block < ix.config.StartBlock+ix.config.ReorgDepth {
+		ix.logger.Warn("Reorg deeper than the indexed range, re-indexing from the start block")
+		return ix.store.Rollback(ctx, nil)
+	}
+
+	safe := reorg.Block - ix.config.ReorgDepth
+	hash, err := ix.blockHash(ctx, safe)
+	if err != nil {
+		return err
+	}
+	ix.logger.WithField("block", safe).Warn("No common ancestor within reorg depth, rolled back to safe block")
+	return ix.store.Rollback(ctx, &Checkpoint{Number: safe, Hash: hash})
+}
+
+func (ix *Indexer) block(ctx context.Context, number uint64) (*web3client.BlockRef, error) {
+	block, err := ix.client.BlockRef(ctx, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block %d: %w", number, err)
+	}
+	return block, nil
+}
+
+func (ix *Indexer) blockHash(ctx context.Context, number uint64) (common.Hash, error) {
+	block, err := ix.block(ctx, number)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return block.Hash, nil
+}
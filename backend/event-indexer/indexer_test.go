@@ -0,0 +1,334 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aegis-yield/backend/web3-client"
+	"github.com/aegis-yield/backend/web3-client/bindings"
+)
+
+var (
+	controllerAddress = common.HexToAddress("0x2000")
+	vaultAddress      = common.HexToAddress("0x1000")
+	depositor         = common.HexToAddress("0xd0")
+)
+
+// deposit is a vault Deposit in a block of a branch
+type deposit struct {
+	block  uint64
+	branch string
+	assets int64
+}
+
+// blockRange is an eth_getLogs request
+type blockRange struct{ from, to uint64 }
+
+// stubChain is a chain whose blocks from forkAt on belong to the fork branch once
+// set, and which serves the deposits of the canonical branch. eth_getLogs fails for
+// ranges longer than maxRange when it is set.
+type stubChain struct {
+	mu       sync.Mutex
+	head     uint64
+	forkAt   uint64
+	fork     string
+	deposits []deposit
+	maxRange uint64
+	queries  []blockRange
+}
+
+func (c *stubChain) branch(number uint64) string {
+	if c.fork != "" && number >= c.forkAt {
+		return c.fork
+	}
+	return "main"
+}
+
+func blockHash(branch string, number uint64) common.Hash {
+	return common.BytesToHash([]byte(fmt.Sprintf("%s:%d", branch, number)))
+}
+
+func (c *stubChain) hash(number uint64) common.Hash {
+	return blockHash(c.branch(number), number)
+}
+
+// reorg replaces every block from number on with the given branch
+func (c *stubChain) reorg(number uint64, branch string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.forkAt, c.fork = number, branch
+}
+
+func (c *stubChain) BlockNumber(ctx context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head, nil
+}
+
+func (c *stubChain) BlockRef(ctx context.Context, number uint64) (*web3client.BlockRef, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if number > c.head {
+		return nil, fmt.Errorf("block %d not found", number)
+	}
+	return &web3client.BlockRef{Number: number, Hash: c.hash(number), ParentHash: c.hash(number - 1), Time: 1_700_000_000 + 2*number}, nil
+}
+
+func (c *stubChain) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	from, to := query.FromBlock.Uint64(), query.ToBlock.Uint64()
+	c.queries = append(c.queries, blockRange{from, to})
+	if c.maxRange > 0 && to-from+1 > c.maxRange {
+		return nil, errors.New("query exceeds max block range")
+	}
+
+	var logs []types.Log
+	for _, d := range c.deposits {
+		if d.block >= from && d.block <= to && d.branch == c.branch(d.block) {
+			logs = append(logs, depositLog(d))
+		}
+	}
+	return logs, nil
+}
+
+// depositLog encodes a vault Deposit of d.assets from depositor
+func depositLog(d deposit) types.Log {
+	vault, err := bindings.AegisVaultMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	event := vault.Events["Deposit"]
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(d.assets), big.NewInt(d.assets))
+	if err != nil {
+		panic(err)
+	}
+	return types.Log{
+		Address:     vaultAddress,
+		Topics:      []common.Hash{event.ID, common.BytesToHash(depositor.Bytes()), common.BytesToHash(depositor.Bytes())},
+		Data:        data,
+		BlockNumber: d.block,
+		BlockHash:   blockHash(d.branch, d.block),
+		TxHash:      blockHash("tx "+d.branch, d.block),
+	}
+}
+
+func newTestIndexer(t *testing.T, chain *stubChain, config Config) (*Indexer, *Store) {
+	t.Helper()
+	store, err := OpenStore(filepath.Join(t.TempDir(), "events.db"))
+	if err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	indexer, err := NewIndexer(chain, store, controllerAddress, vaultAddress, config, logger)
+	if err != nil {
+		t.Fatalf("NewIndexer: %v", err)
+	}
+	return indexer, store
+}
+
+// syncAll syncs until the indexer catches up, failing after limit steps
+func syncAll(t *testing.T, indexer *Indexer, limit int) {
+	t.Helper()
+	for i := 0; i < limit; i++ {
+		caughtUp, err := indexer.Sync(context.Background())
+		if err != nil {
+			t.Fatalf("Sync: %v", err)
+		}
+		if caughtUp {
+			return
+		}
+	}
+	t.Fatalf("not caught up after %d syncs", limit)
+}
+
+// storedDeposits returns the stored deposits, oldest first
+func storedDeposits(t *testing.T, store *Store) []deposit {
+	t.Helper()
+	events, err := store.Events(context.Background(), EventQuery{})
+	if err != nil {
+		t.Fatalf("Events: %v", err)
+	}
+	var deposits []deposit
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		assets, _ := new(big.Int).SetString(event.Args["assets"], 10)
+		d := deposit{block: event.BlockNumber, assets: assets.Int64()}
+		for _, branch := range []string{"main", "fork"} {
+			if event.BlockHash == blockHash(branch, event.BlockNumber) {
+				d.branch = branch
+			}
+		}
+		deposits = append(deposits, d)
+	}
+	return deposits
+}
+
+func head(t *testing.T, store *Store) uint64 {
+	t.Helper()
+	checkpoint, err := store.Head(context.Background())
+	if err != nil {
+		t.Fatalf("Head: %v", err)
+	}
+	if checkpoint == nil {
+		return 0
+	}
+	return checkpoint.Number
+}
+
+func TestIndexerBackfillChunks(t *testing.T) {
+	chain := &stubChain{
+		head: 127,
+		deposits: []deposit{
+			{100, "main", 1}, {109, "main", 2}, {110, "main", 3}, {125, "main", 4}, {126, "main", 5},
+		},
+	}
+	indexer, store := newTestIndexer(t, chain, Config{StartBlock: 100, ChunkSize: 10, Confirmations: 2, ReorgDepth: 64})
+
+	// Chunks end at the confirmed head, 125
+	for i, want := range []bool{false, false, true, true} {
+		caughtUp, err := indexer.Sync(context.Background())
+		if err != nil {
+			t.Fatalf("Sync %d: %v", i, err)
+		}
+		if caughtUp != want {
+			t.Errorf("Sync %d caught up = %v, want %v", i, caughtUp, want)
+		}
+	}
+	wantQueries := []blockRange{{100, 109}, {110, 119}, {120, 125}}
+	if !reflect.DeepEqual(chain.queries, wantQueries) {
+		t.Errorf("queries = %v, want %v", chain.queries, wantQueries)
+	}
+	wantDeposits := []deposit{{100, "main", 1}, {109, "main", 2}, {110, "main", 3}, {125, "main", 4}}
+	if got := storedDeposits(t, store); !reflect.DeepEqual(got, wantDeposits) {
+		t.Errorf("deposits = %v, want %v", got, wantDeposits)
+	}
+	if got := head(t, store); got != 125 {
+		t.Errorf("head = %d, want 125", got)
+	}
+
+	// Following the head resumes after the last indexed block
+	chain.head = 130
+	syncAll(t, indexer, 1)
+	if last := chain.queries[len(chain.queries)-1]; last != (blockRange{126, 128}) {
+		t.Errorf("last query = %v, want 126-128", last)
+	}
+	if got := storedDeposits(t, store); len(got) != 5 || got[4] != (deposit{126, "main", 5}) {
+		t.Errorf("deposits = %v, want the deposit at 126 added", got)
+	}
+}
+
+func TestIndexerShrinksChunksOnProviderLimits(t *testing.T) {
+	chain := &stubChain{
+		head:     120,
+		maxRange: 3,
+		deposits: []deposit{{100, "main", 1}, {101, "main", 2}, {102, "main", 3}, {109, "main", 4}, {110, "main", 5}},
+	}
+	indexer, store := newTestIndexer(t, chain, Config{StartBlock: 100, ChunkSize: 10, ReorgDepth: 64})
+
+	// 10 and 5 blocks exceed the limit; 2 fit
+	for i := 0; i < 2; i++ {
+		if _, err := indexer.Sync(context.Background()); err == nil {
+			t.Fatalf("Sync %d succeeded over the provider's range limit", i)
+		}
+	}
+	syncAll(t, indexer, 20)
+
+	wantQueries := []blockRange{{100, 109}, {100, 104}, {100, 101}, {102, 103}, {104, 105}}
+	if !reflect.DeepEqual(chain.queries[:len(wantQueries)], wantQueries) {
+		t.Errorf("queries = %v, want to start with %v", chain.queries, wantQueries)
+	}
+	for _, query := range chain.queries[2:] {
+		if query.to-query.from+1 > 2 {
+			t.Errorf("query %v exceeds the reduced chunk size", query)
+		}
+	}
+	// No block is skipped or indexed twice across the shrunk chunks
+	if got := storedDeposits(t, store); !reflect.DeepEqual(got, chain.deposits) {
+		t.Errorf("deposits = %v, want %v", got, chain.deposits)
+	}
+	if got := head(t, store); got != 120 {
+		t.Errorf("head = %d, want 120", got)
+	}
+}
+
+func TestIndexerReorg(t *testing.T) {
+	tests := []struct {
+		name       string
+		reorgDepth uint64
+		forkAt     uint64
+		rolledBack uint64 // Head after the rollback
+	}{
+		// The deposit at 112 is the newest checkpoint still on the canonical chain
+		{"common ancestor", 64, 115, 112},
+		// Checkpoints below 117 are pruned, so none survives the reorg: fall back to
+		// the block ReorgDepth below the stale head
+		{"deeper than the checkpoints", 8, 100, 117},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &stubChain{
+				head: 125,
+				deposits: []deposit{
+					{100, "main", 1}, {112, "main", 2}, {118, "main", 3}, {122, "main", 4},
+					// Only on the fork
+					{116, "fork", 5}, {118, "fork", 6},
+				},
+			}
+			indexer, store := newTestIndexer(t, chain, Config{StartBlock: 100, ChunkSize: 10, ReorgDepth: tt.reorgDepth})
+			syncAll(t, indexer, 10)
+
+			chain.reorg(tt.forkAt, "fork")
+
+			caughtUp, err := indexer.Sync(context.Background())
+			if err != nil || caughtUp {
+				t.Fatalf("Sync = %v, %v; want a rollback", caughtUp, err)
+			}
+			if got := head(t, store); got != tt.rolledBack {
+				t.Fatalf("head after rollback = %d, want %d", got, tt.rolledBack)
+			}
+			for _, d := range storedDeposits(t, store) {
+				if d.block > tt.rolledBack {
+					t.Errorf("deposit %v above the rollback point kept", d)
+				}
+			}
+
+			syncAll(t, indexer, 10)
+
+			// Re-indexed blocks come from the fork; blocks below the rollback point keep
+			// what was indexed before it
+			var want []deposit
+			for _, d := range chain.deposits {
+				if d.block <= tt.rolledBack && d.branch == "main" || d.block > tt.rolledBack && d.branch == chain.branch(d.block) {
+					want = append(want, d)
+				}
+			}
+			got := storedDeposits(t, store)
+			sort.Slice(want, func(i, j int) bool { return want[i].block < want[j].block })
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("deposits = %v, want %v", got, want)
+			}
+			if checkpoint, err := store.Head(context.Background()); err != nil || checkpoint.Number != 125 || checkpoint.Hash != blockHash("fork", 125) {
+				t.Errorf("head = %+v, %v; want the fork's block 125", checkpoint, err)
+			}
+		})
+	}
+}
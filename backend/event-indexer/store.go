@@ -0,0 +1,271 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	_ "modernc.org/sqlite" // Registers the pure Go "sqlite" driver
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS events (
+	block_number INTEGER NOT NULL,
+	log_index    INTEGER NOT NULL,
+	block_hash   TEXT    NOT NULL,
+	block_time   INTEGER NOT NULL,
+	tx_hash      TEXT    NOT NULL,
+	tx_index     INTEGER NOT NULL,
+	contract     TEXT    NOT NULL,
+	address      TEXT    NOT NULL,
+	name         TEXT    NOT NULL,
+	args         TEXT    NOT NULL,
+	PRIMARY KEY (block_number, log_index)
+);
+CREATE INDEX IF NOT EXISTS events_by_name ON events (name, block_number);
+CREATE INDEX IF NOT EXISTS events_by_time ON events (block_time);
+
+CREATE TABLE IF NOT EXISTS checkpoints (
+	number INTEGER PRIMARY KEY,
+	hash   TEXT NOT NULL
+);
+`
+
+// Checkpoint is an indexed block and the hash it had when it was indexed. The
+// highest checkpoint is the indexer's cursor; older ones are kept for reorg detection.
+type Checkpoint struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// EventQuery filters stored events. Zero values do not filter.
+type EventQuery struct {
+	Contract  string
+	Names     []string
+	FromBlock uint64
+	ToBlock   uint64
+	Since     time.Time
+	Until     time.Time
+	Limit     int
+	Offset    int
+}
+
+// Store persists decoded events in an embedded SQLite database
+type Store struct {
+	db *sql.DB
+}
+
+// OpenStore opens or creates the SQLite database at path
+func OpenStore(path string) (*Store, error) {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create database directory: %w", err)
+		}
+	}
+
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event store: %w", err)
+	}
+	// SQLite allows a single writer; readers share the connection
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create event store schema: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Head returns the highest indexed block, or nil when nothing has been indexed
+func (s *Store) Head(ctx context.Context) (*Checkpoint, error) {
+	checkpoints, err := s.Checkpoints(ctx, 1)
+	if err != nil || len(checkpoints) == 0 {
+		return nil, err
+	}
+	return &checkpoints[0], nil
+}
+
+// Checkpoints returns up to limit checkpoints, highest first
+func (s *Store) Checkpoints(ctx context.Context, limit int) ([]Checkpoint, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT number, hash FROM checkpoints ORDER BY number DESC LIMIT ?`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query checkpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var checkpoints []Checkpoint
+	for rows.Next() {
+		var checkpoint Checkpoint
+		var hash string
+		if err := rows.Scan(&checkpoint.Number, &hash); err != nil {
+			return nil, fmt.Errorf("failed to scan checkpoint: %w", err)
+		}
+		checkpoint.Hash = common.HexToHash(hash)
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	return checkpoints, rows.Err()
+}
+
+// SaveRange atomically stores the events of an indexed block range together with its
+// checkpoints, and prunes checkpoints below pruneBelow
+func (s *Store) SaveRange(ctx context.Context, events []Event, checkpoints []Checkpoint, pruneBelow uint64) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		for _, event := range events {
+			args, err := json.Marshal(event.Args)
+			if err != nil {
+				return fmt.Errorf("failed to encode %s args: %w", event.Name, err)
+			}
+			if _, err := tx.ExecContext(ctx,
+				`INSERT OR REPLACE INTO events (block_number, log_index, block_hash, block_time, tx_hash, tx_index, contract, address, name, args)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				event.BlockNumber, event.LogIndex, event.BlockHash.Hex(), event.BlockTime.Unix(), event.TxHash.Hex(),
+				event.TxIndex, event.Contract, event.Address.Hex(), event.Name, string(args),
+			); err != nil {
+				return fmt.Errorf("failed to insert %s event: %w", event.Name, err)
+			}
+		}
+
+		for _, checkpoint := range checkpoints {
+			if _, err := tx.ExecContext(ctx, `INSERT OR REPLACE INTO checkpoints (number, hash) VALUES (?, ?)`, checkpoint.Number, checkpoint.Hash.Hex()); err != nil {
+				return fmt.Errorf("failed to insert checkpoint: %w", err)
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM checkpoints WHERE number < ?`, pruneBelow); err != nil {
+			return fmt.Errorf("failed to prune checkpoints: %w", err)
+		}
+		return nil
+	})
+}
+
+// Rollback deletes everything indexed above the given checkpoint and makes it the head.
+// A nil checkpoint clears the store.
+func (s *Store) Rollback(ctx context.Context, to *Checkpoint) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		// Deleting from block 0 clears everything
+		above := int64(-1)
+		if to != nil {
+			above = int64(to.Number)
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM events WHERE block_number > ?`, above); err != nil {
+			return fmt.Errorf("failed to delete events: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM checkpoints WHERE number > ?`, above); err != nil {
+			return fmt.Errorf("failed to delete checkpoints: %w", err)
+		}
+		if to != nil {
+			if _, err := tx.ExecContext(ctx, `INSERT OR REPLACE INTO checkpoints (number, hash) VALUES (?, ?)`, to.Number, to.Hash.Hex()); err != nil {
+				return fmt.Errorf("failed to insert checkpoint: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// Events returns stored events matching the query, newest first
+func (s *Store) Events(ctx context.Context, query EventQuery) ([]Event, error) {
+	var where []string
+	var args []interface{}
+
+	if query.Contract != "" {
+		where = append(where, "contract = ?")
+		args = append(args, query.Contract)
+	}
+	if len(query.Names) > 0 {
+		where = append(where, "name IN (?"+strings.Repeat(", ?", len(query.Names)-1)+")")
+		for _, name := range query.Names {
+			args = append(args, name)
+		}
+	}
+	if query.FromBlock > 0 {
+		where = append(where, "block_number >= ?")
+		args = append(args, query.FromBlock)
+	}
+	if query.ToBlock > 0 {
+		where = append(where, "block_number <= ?")
+		args = append(args, query.ToBlock)
+	}
+	if !query.Since.IsZero() {
+		where = append(where, "block_time >= ?")
+		args = append(args, query.Since.Unix())
+	}
+	if !query.Until.IsZero() {
+		where = append(where, "block_time <= ?")
+		args = append(args, query.Until.Unix())
+	}
+
+	statement := `SELECT block_number, log_index, block_hash, block_time, tx_hash, tx_index, contract, address, name, args FROM events`
+	if len(where) > 0 {
+		statement += " WHERE " + strings.Join(where, " AND ")
+	}
+	statement += " ORDER BY block_number DESC, log_index DESC"
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = -1 // SQLite: no limit
+	}
+	statement += " LIMIT ? OFFSET ?"
+	args = append(args, limit, query.Offset)
+
+	rows, err := s.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []Event
+	for rows.Next() {
+		var event Event
+		var blockHash, txHash, address, encodedArgs string
+		var blockTime int64
+		if err := rows.Scan(&event.BlockNumber, &event.LogIndex, &blockHash, &blockTime, &txHash, &event.TxIndex,
+			&event.Contract, &address, &event.Name, &encodedArgs); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		if err := json.Unmarshal([]byte(encodedArgs), &event.Args); err != nil {
+			return nil, fmt.Errorf("failed to decode %s args: %w", event.Name, err)
+		}
+		event.BlockHash = common.HexToHash(blockHash)
+		event.BlockTime = time.Unix(blockTime, 0).UTC()
+		event.TxHash = common.HexToHash(txHash)
+		event.Address = common.HexToAddress(address)
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func (s *Store) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rollbackErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
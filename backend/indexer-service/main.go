@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aegis-yield/backend/event-indexer"
+	"github.com/aegis-yield/backend/pkg/config"
+	"github.com/aegis-yield/backend/web3-client"
+)
+
+var logger = logrus.New()
+
+func main() {
+	// Configure logger
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	logger.SetLevel(logrus.InfoLevel)
+
+	logger.Info("Starting Aegis Yield Event Indexer...")
+
+	// Load and validate configuration
+	cfg, err := config.LoadConfig()
+	if err == nil {
+		err = cfg.ValidateIndexer()
+	}
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	level, _ := logrus.ParseLevel(cfg.LogLevel)
+	logger.SetLevel(level)
+
+	artifacts, err := web3client.LoadDeploymentArtifacts(cfg.DeploymentArtifactsPath)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to load deployment artifacts")
+	}
+	if artifacts.ChainID != uint64(cfg.BaseChainID) {
+		logger.WithFields(logrus.Fields{
+			"configured": cfg.BaseChainID,
+			"artifacts":  artifacts.ChainID,
+		}).Fatal("BASE_CHAIN_ID does not match the deployment artifacts")
+	}
+
+	// Connect to every configured RPC endpoint with health checks and failover
+	rpcClient, err := web3client.DialMultiClient(context.Background(), cfg.BaseRPCURLs, web3client.MultiClientConfig{
+		HealthCheckInterval: cfg.RPCHealthCheckInterval,
+		MaxBlockLag:         cfg.RPCMaxBlockLag,
+		MaxLatency:          cfg.RPCMaxLatency,
+		RequestTimeout:      cfg.RPCRequestTimeout,
+		Quorum:              cfg.RPCQuorum,
+	}, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to connect to Base RPC")
+	}
+	defer rpcClient.Close()
+
+	chainID, err := rpcClient.ChainID(context.Background())
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to get chain ID")
+	}
+	if !chainID.IsUint64() || chainID.Uint64() != artifacts.ChainID {
+		logger.Fatal((&web3client.ChainMismatchError{Expected: artifacts.ChainID, Actual: chainID.Uint64()}).Error())
+	}
+
+	store, err := indexer.OpenStore(cfg.IndexerDBPath)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to open event store")
+	}
+	defer store.Close()
+
+	eventIndexer, err := indexer.NewIndexer(rpcClient, store, artifacts.ControllerProxy, artifacts.VaultProxy, indexer.Config{
+		StartBlock:    cfg.IndexerStartBlock,
+		ChunkSize:     cfg.IndexerChunkSize,
+		Confirmations: cfg.IndexerConfirmations,
+		PollInterval:  cfg.IndexerPollInterval,
+		ReorgDepth:    cfg.IndexerReorgDepth,
+	}, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to initialize event indexer")
+	}
+
+	// Create context with cancellation
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Setup graceful shutdown
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-sigChan
+		logger.Info("Shutdown signal received, stopping event indexer...")
+		cancel()
+	}()
+
+	if err := eventIndexer.Run(ctx); err != nil {
+		logger.WithError(err).Fatal("Event indexer failed")
+	}
+
+	logger.Info("Event indexer stopped gracefully")
+}
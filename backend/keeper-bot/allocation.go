@@ -0,0 +1,229 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aegis-yield/backend/optimization-solver"
+	"github.com/aegis-yield/backend/pkg/config"
+)
+
+// basisPoints is the controller's allocation limit denominator
+const basisPoints = 10_000
+
+// AllocationPlan is an allocator's target weights
+type AllocationPlan struct {
+	Results []solver.AllocationResult
+	// Turnover is the expected value of trading to the targets, for cost-aware modes
+	Turnover *solver.TurnoverResult
+}
+
+// Allocator computes target weights for the solver inputs
+type Allocator func(inputs []solver.StrategyInput, totalAssets float64) (*AllocationPlan, error)
+
+// adjustReturns wraps an allocator so that expected returns are adjusted for
+// prediction uncertainty by the return model before allocating. Correlations are
+// optional; without them strategies are treated as uncorrelated.
+func adjustReturns(allocate Allocator, model solver.ReturnModel, correlations config.Correlations) Allocator {
+	if model == solver.ReturnsPredicted {
+		return allocate
+	}
+
+	return func(inputs []solver.StrategyInput, totalAssets float64) (*AllocationPlan, error) {
+		correlation, err := correlationMatrix(correlations, inputs)
+		if err != nil {
+			return nil, err
+		}
+		adjusted, err := solver.AdjustReturns(inputs, solver.ReturnConfig{Model: model, Correlation: correlation})
+		if err != nil {
+			return nil, err
+		}
+		return allocate(adjusted, totalAssets)
+	}
+}
+
+// warnUnknownStrategies wraps an allocator to warn when the constraint set names
+// strategies the controller does not have. The solver ignores them, so a strategy
+// removed on-chain does not stop rebalancing.
+func warnUnknownStrategies(allocate Allocator, constraints *solver.ConstraintSet, logger *logrus.Logger) Allocator {
+	return func(inputs []solver.StrategyInput, totalAssets float64) (*AllocationPlan, error) {
+		if unknown := constraints.UnknownStrategies(inputs); len(unknown) > 0 {
+			logger.WithField("strategies", unknown).Warn("SOLVER_CONSTRAINTS_FILE names strategies the controller does not have; ignoring them")
+		}
+		return allocate(inputs, totalAssets)
+	}
+}
+
+// correlationMatrix returns the correlation matrix of the solver inputs, whose names
+// are strategy addresses, or nil when no correlations are configured
+func correlationMatrix(correlations config.Correlations, inputs []solver.StrategyInput) ([][]float64, error) {
+	if correlations == nil {
+		return nil, nil
+	}
+	addresses := make([]common.Address, len(inputs))
+	for i, input := range inputs {
+		addresses[i] = common.HexToAddress(input.Name)
+	}
+	return correlations.Matrix(addresses)
+}
+
+// buildSolverInputs maps on-chain portfolio state and ML predictions into solver inputs.
+// Strategies are identified by their address so results can be mapped back.
+func buildSolverInputs(state *PortfolioState, predictions []MLPrediction) ([]solver.StrategyInput, error) {
+	byAddress := make(map[common.Address]MLPrediction, len(predictions))
+	for _, prediction := range predictions {
+		byAddress[prediction.StrategyAddress] = prediction
+	}
+
+	total := new(big.Float).SetInt(state.TotalAssets)
+
+	inputs := make([]solver.StrategyInput, 0, len(state.Strategies))
+	for _, strategy := range state.Strategies {
+		prediction, ok := byAddress[strategy.Address]
+		if !ok {
+			return nil, fmt.Errorf("no ML prediction for strategy %s", strategy.Address.Hex())
+		}
+
+		currentAlloc := 0.0
+		if state.TotalAssets.Sign() > 0 {
+			currentAlloc, _ = new(big.Float).Quo(new(big.Float).SetInt(strategy.CurrentAmount), total).Float64()
+		}
+
+		riskScore, _ := new(big.Float).SetInt(strategy.RiskScore).Float64()
+		maxAllocation, _ := new(big.Float).Quo(
+			new(big.Float).SetInt(strategy.AllocationLimit),
+			big.NewFloat(basisPoints),
+		).Float64()
+
+		priorReturn := 0.0
+		if strategy.APY != nil {
+			priorReturn, _ = new(big.Float).Quo(new(big.Float).SetInt(strategy.APY), big.NewFloat(basisPoints)).Float64()
+		}
+
+		inputs = append(inputs, solver.StrategyInput{
+			Name:             strategy.Address.Hex(),
+			CurrentAlloc:     currentAlloc,
+			ExpectedReturn:   prediction.PredictedAPY,
+			Volatility:       prediction.PredictedVol,
+			RiskScore:        riskScore,
+			MaxAllocation:    maxAllocation,
+			Confidence:       prediction.Confidence,
+			PriorReturn:      priorReturn,
+			ReturnLowerBound: returnLowerBound(prediction),
+		})
+	}
+
+	return inputs, nil
+}
+
+// returnLowerBound is the lower end of the predicted APY's interval. When the ML engine
+// does not provide one, the prediction is discounted by the model's lack of
+// confidence: a prediction held with confidence c keeps the fraction c of its size.
+func returnLowerBound(prediction MLPrediction) float64 {
+	if prediction.APYLower != nil {
+		return *prediction.APYLower
+	}
+	return prediction.PredictedAPY - (1-prediction.Confidence)*math.Abs(prediction.PredictedAPY)
+}
+
+// weightsToAmounts converts portfolio weights into amounts in the asset's base units.
+// Each weight is converted exactly as a rational and floored, and no amount may exceed
+// its strategy's cap of floor(totalAssets * limit / 10000), limits being in basis
+// points with nil meaning uncapped. The remaining dust, including whatever the caps cut
+// off, is handed out one unit at a time by largest fractional remainder, then in bulk,
+// always to the next strategy with room below its cap, so the amounts sum to exactly
+// totalAssets.
+func weightsToAmounts(totalAssets *big.Int, weights []float64, limits []*big.Int) ([]*big.Int, error) {
+	if len(limits) != len(weights) {
+		return nil, fmt.Errorf("got %d allocation limits for %d weights", len(limits), len(weights))
+	}
+
+	weightSum := new(big.Rat)
+	rats := make([]*big.Rat, len(weights))
+	for i, weight := range weights {
+		if math.IsNaN(weight) || math.IsInf(weight, 0) || weight < 0 {
+			return nil, fmt.Errorf("invalid weight %v at index %d", weight, i)
+		}
+		rats[i] = new(big.Rat).SetFloat64(weight)
+		weightSum.Add(weightSum, rats[i])
+	}
+	if weightSum.Sign() == 0 {
+		return nil, fmt.Errorf("weights sum to zero")
+	}
+
+	type remainder struct {
+		index int
+		frac  *big.Rat
+	}
+
+	total := new(big.Rat).SetInt(totalAssets)
+	amounts := make([]*big.Int, len(weights))
+	caps := make([]*big.Int, len(weights))
+	remainders := make([]remainder, len(weights))
+	allocated := new(big.Int)
+
+	for i, weight := range rats {
+		// exact = totalAssets * weight / sum(weights)
+		exact := new(big.Rat).Mul(total, weight)
+		exact.Quo(exact, weightSum)
+
+		floor := new(big.Int).Quo(exact.Num(), exact.Denom())
+		frac := new(big.Rat).Sub(exact, new(big.Rat).SetInt(floor))
+		remainders[i] = remainder{index: i, frac: frac}
+
+		if limits[i] != nil {
+			caps[i] = new(big.Int).Mul(totalAssets, limits[i])
+			caps[i].Quo(caps[i], big.NewInt(basisPoints))
+			if floor.Cmp(caps[i]) > 0 {
+				floor.Set(caps[i])
+			}
+		}
+		amounts[i] = floor
+		allocated.Add(allocated, floor)
+	}
+
+	sort.SliceStable(remainders, func(a, b int) bool {
+		return remainders[a].frac.Cmp(remainders[b].frac) > 0
+	})
+
+	// room is how much more a strategy can take, nil when it is uncapped
+	room := func(index int) *big.Int {
+		if caps[index] == nil {
+			return nil
+		}
+		return new(big.Int).Sub(caps[index], amounts[index])
+	}
+
+	dust := new(big.Int).Sub(totalAssets, allocated)
+	one := big.NewInt(1)
+	for _, r := range remainders {
+		if dust.Sign() == 0 {
+			break
+		}
+		if space := room(r.index); space == nil || space.Sign() > 0 {
+			amounts[r.index].Add(amounts[r.index], one)
+			dust.Sub(dust, one)
+		}
+	}
+	for _, r := range remainders {
+		if dust.Sign() == 0 {
+			break
+		}
+		give := new(big.Int).Set(dust)
+		if space := room(r.index); space != nil && space.Cmp(give) < 0 {
+			give = space
+		}
+		amounts[r.index].Add(amounts[r.index], give)
+		dust.Sub(dust, give)
+	}
+	if dust.Sign() > 0 {
+		return nil, fmt.Errorf("allocation limits leave %s of %s base units unallocated", dust, totalAssets)
+	}
+
+	return amounts, nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestWeightsToAmounts(t *testing.T) {
+	bps := func(limit int64) *big.Int { return big.NewInt(limit) }
+	large, _ := new(big.Int).SetString("1000000000000000000000001", 10)
+
+	tests := []struct {
+		name    string
+		total   *big.Int
+		weights []float64
+		limits  []*big.Int
+		want    []int64 // nil to only check the invariants
+		wantErr bool
+	}{
+		{
+			name:    "dust by largest remainder",
+			total:   big.NewInt(100),
+			weights: []float64{1.0 / 3, 1.0 / 3, 1.0 / 3},
+			limits:  []*big.Int{nil, nil, nil},
+			want:    []int64{34, 33, 33},
+		},
+		{
+			name:    "weights not summing to one",
+			total:   big.NewInt(100),
+			weights: []float64{2, 6},
+			limits:  []*big.Int{nil, nil},
+			want:    []int64{25, 75},
+		},
+		{
+			name:    "cap moves the excess to uncapped strategies",
+			total:   big.NewInt(1000),
+			weights: []float64{0.9, 0.1},
+			limits:  []*big.Int{bps(6000), nil},
+			want:    []int64{600, 400},
+		},
+		{
+			name:    "dust skips a full strategy",
+			total:   big.NewInt(1000003),
+			weights: []float64{0.5, 0.3, 0.2},
+			limits:  []*big.Int{bps(5000), nil, nil},
+			want:    []int64{500001, 300001, 200001},
+		},
+		{
+			name:    "cap floors the share",
+			total:   big.NewInt(999),
+			weights: []float64{0.5, 0.5},
+			limits:  []*big.Int{bps(3333), bps(10000)},
+			want:    []int64{332, 667},
+		},
+		{
+			name:    "large total under caps",
+			total:   large,
+			weights: []float64{0.6, 0.2, 0.2},
+			limits:  []*big.Int{bps(4000), bps(4000), bps(4000)},
+		},
+		{
+			name:    "zero total",
+			total:   big.NewInt(0),
+			weights: []float64{0.5, 0.5},
+			limits:  []*big.Int{bps(5000), nil},
+			want:    []int64{0, 0},
+		},
+		{
+			name:    "caps cannot hold the total",
+			total:   big.NewInt(10),
+			weights: []float64{0.5, 0.5},
+			limits:  []*big.Int{bps(4000), bps(4000)},
+			wantErr: true,
+		},
+		{
+			name:    "NaN weight",
+			total:   big.NewInt(100),
+			weights: []float64{math.NaN(), 1},
+			limits:  []*big.Int{nil, nil},
+			wantErr: true,
+		},
+		{
+			name:    "negative weight",
+			total:   big.NewInt(100),
+			weights: []float64{-0.2, 1.2},
+			limits:  []*big.Int{nil, nil},
+			wantErr: true,
+		},
+		{
+			name:    "infinite weight",
+			total:   big.NewInt(100),
+			weights: []float64{math.Inf(1), 1},
+			limits:  []*big.Int{nil, nil},
+			wantErr: true,
+		},
+		{
+			name:    "zero weights",
+			total:   big.NewInt(100),
+			weights: []float64{0, 0},
+			limits:  []*big.Int{nil, nil},
+			wantErr: true,
+		},
+		{
+			name:    "missing limits",
+			total:   big.NewInt(100),
+			weights: []float64{0.5, 0.5},
+			limits:  []*big.Int{nil},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amounts, err := weightsToAmounts(tt.total, tt.weights, tt.limits)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("weightsToAmounts = %v, want an error", amounts)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("weightsToAmounts: %v", err)
+			}
+
+			sum := new(big.Int)
+			for i, amount := range amounts {
+				sum.Add(sum, amount)
+				if amount.Sign() < 0 {
+					t.Errorf("amount %d = %s, want non-negative", i, amount)
+				}
+				if tt.limits[i] == nil {
+					continue
+				}
+				// floor(total * limit / 10000)
+				limit := new(big.Int).Mul(tt.total, tt.limits[i])
+				limit.Quo(limit, big.NewInt(basisPoints))
+				if amount.Cmp(limit) > 0 {
+					t.Errorf("amount %d = %s, above its cap %s", i, amount, limit)
+				}
+			}
+			if sum.Cmp(tt.total) != 0 {
+				t.Errorf("amounts %v sum to %s, want %s", amounts, sum, tt.total)
+			}
+
+			if tt.want == nil {
+				return
+			}
+			for i, want := range tt.want {
+				if amounts[i].Cmp(big.NewInt(want)) != 0 {
+					t.Errorf("amounts = %v, want %v", amounts, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
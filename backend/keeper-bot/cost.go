@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aegis-yield/backend/data-aggregator"
+	"github.com/aegis-yield/backend/web3-client"
+)
+
+// ChainCostEstimator estimates rebalance cost on Base, including the L1 data fee
+type ChainCostEstimator struct {
+	contractManager *web3client.ContractManager
+	prices          *aggregator.DataAggregator
+	ethPriceUSD     float64
+}
+
+// NewChainCostEstimator creates a cost estimator that values gas at the ETH/USD price
+// reported by prices, or at the fixed ethPriceUSD when prices has no ETH/USD source
+func NewChainCostEstimator(cm *web3client.ContractManager, prices *aggregator.DataAggregator, ethPriceUSD float64) *ChainCostEstimator {
+	return &ChainCostEstimator{
+		contractManager: cm,
+		prices:          prices,
+		ethPriceUSD:     ethPriceUSD,
+	}
+}
+
+// EstimateRebalanceCost implements CostEstimator
+func (e *ChainCostEstimator) EstimateRebalanceCost(ctx context.Context, req *RebalanceRequest) (*web3client.CostEstimate, error) {
+	data, err := web3client.PackRebalance(req.Targets())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode rebalance call: %w", err)
+	}
+
+	ethPriceUSD := e.ethPriceUSD
+	if e.prices.Provides(aggregator.FieldETHPrice) {
+		price, err := e.prices.ETHPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ETH price: %w", err)
+		}
+		ethPriceUSD = price.Value
+	}
+
+	return e.contractManager.EstimateTotalCost(ctx, e.contractManager.GetControllerAddress(), data, ethPriceUSD)
+}
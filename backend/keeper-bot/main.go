@@ -2,61 +2,190 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"math/big"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
-	"github.com/joho/godotenv"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/sirupsen/logrus"
-	
+
+	"github.com/aegis-yield/backend/data-aggregator"
+	"github.com/aegis-yield/backend/ml-client"
+	"github.com/aegis-yield/backend/optimization-solver"
+	"github.com/aegis-yield/backend/pkg/config"
+	"github.com/aegis-yield/backend/pkg/history"
 	"github.com/aegis-yield/backend/web3-client"
 )
 
 var logger = logrus.New()
 
-func main() {
-	// Load environment variables
-	if err := godotenv.Load(); err != nil {
-		logger.Warn("No .env file found")
-	}
+// assetDecimals are the decimals of the vault asset (USDC)
+const assetDecimals = 6
 
+func main() {
 	// Configure logger
 	logger.SetFormatter(&logrus.JSONFormatter{})
 	logger.SetLevel(logrus.InfoLevel)
 
 	logger.Info("Starting Aegis Yield Keeper Bot...")
 
-	// Load configuration from environment
-	rpcURL := os.Getenv("BASE_RPC_URL")
-	if rpcURL == "" {
-		rpcURL = "https://mainnet.base.org"
+	// Load and validate configuration
+	cfg, err := config.LoadConfig()
+	if err == nil {
+		err = cfg.ValidateKeeper()
 	}
-
-	artifactsPath := os.Getenv("DEPLOYMENT_ARTIFACTS_PATH")
-	if artifactsPath == "" {
-		artifactsPath = "./deployments/base-deployment.json"
+	if err != nil {
+		logger.Fatal(err.Error())
 	}
 
-	privateKeyHex := os.Getenv("KEEPER_PRIVATE_KEY")
-	if privateKeyHex == "" {
-		logger.Fatal("KEEPER_PRIVATE_KEY environment variable is required")
+	level, _ := logrus.ParseLevel(cfg.LogLevel)
+	logger.SetLevel(level)
+
+	// Connect to every configured RPC endpoint with health checks and failover
+	rpcClient, err := web3client.DialMultiClient(context.Background(), cfg.BaseRPCURLs, web3client.MultiClientConfig{
+		HealthCheckInterval: cfg.RPCHealthCheckInterval,
+		MaxBlockLag:         cfg.RPCMaxBlockLag,
+		MaxLatency:          cfg.RPCMaxLatency,
+		RequestTimeout:      cfg.RPCRequestTimeout,
+		Quorum:              cfg.RPCQuorum,
+	}, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to connect to Base RPC")
 	}
 
-	mlAPIURL := os.Getenv("ML_API_URL")
-	if mlAPIURL == "" {
-		mlAPIURL = "http://localhost:5000"
+	// Load the keeper signer
+	signer, err := buildSigner(context.Background(), cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to initialize keeper signer")
+	}
+	logger.WithFields(logrus.Fields{
+		"signer":  cfg.KeeperSigner,
+		"address": signer.Address().Hex(),
+	}).Info("Keeper signer loaded")
+	if cfg.KeeperSigner == "raw" {
+		logger.Warn("Using a plaintext private key from the environment; use KEEPER_SIGNER=keystore or remote outside development")
 	}
 
 	// Initialize contract manager
-	contractManager, err := web3client.NewContractManager(rpcURL, artifactsPath, privateKeyHex, logger)
+	contractManager, err := web3client.NewContractManager(rpcClient, cfg.DeploymentArtifactsPath, signer, logger)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to initialize contract manager")
 	}
 	defer contractManager.Close()
 
+	// Refuse to start against the wrong chain, missing contracts or without KEEPER_ROLE
+	if artifactsChainID := contractManager.GetArtifacts().ChainID; artifactsChainID != uint64(cfg.BaseChainID) {
+		logger.WithFields(logrus.Fields{
+			"configured": cfg.BaseChainID,
+			"artifacts":  artifactsChainID,
+		}).Fatal("BASE_CHAIN_ID does not match the deployment artifacts")
+	}
+
+	preflightCtx, cancelPreflight := context.WithTimeout(context.Background(), 30*time.Second)
+	report, err := contractManager.Preflight(preflightCtx)
+	cancelPreflight()
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	logger.WithField("checks", len(report.Checks)).Info("Preflight checks passed")
+
+	trackerConfig := web3client.DefaultTrackerConfig()
+	trackerConfig.Confirmations = cfg.TxConfirmations
+	trackerConfig.Timeout = cfg.TxConfirmationTimeout
+	contractManager.SetTrackerConfig(trackerConfig)
+	contractManager.SetStuckTimeout(cfg.StuckTxTimeout)
+
+	// EIP-1559 fee strategy and hard ceiling
+	feeStrategy, err := buildFeeStrategy(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Invalid fee configuration")
+	}
+	contractManager.SetFeeStrategy(feeStrategy, web3client.GweiToWei(cfg.MaxFeeCeilingGwei))
+
+	// Initialize ML engine client
+	mlClient, err := mlclient.NewClient(cfg.MLAPIUrl, mlclient.DefaultOptions(), logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to initialize ML client")
+	}
+
+	if health, err := mlClient.Health(context.Background()); err != nil {
+		logger.WithError(err).Warn("ML engine health check failed")
+	} else if !health.ModelLoaded {
+		logger.Warn("ML engine is running without a loaded model")
+	}
+
+	// Market data: gas is valued at the Chainlink ETH/USD price when a feed is configured,
+	// runs are skipped while feeds are stale or the sequencer is recovering, and each
+	// run records the market it saw
+	prices, err := buildDataAggregator(cfg, rpcClient)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to initialize data aggregator")
+	}
+	if !prices.Provides(aggregator.FieldETHPrice) {
+		logger.WithField("ethPriceUSD", cfg.ETHPriceUSD).Warn("CHAINLINK_ETH_USD_FEED is not set; valuing gas at the fixed ETH_PRICE_USD")
+	}
+	if !prices.Provides(aggregator.FieldSequencerUptime) {
+		logger.Warn("CHAINLINK_SEQUENCER_UPTIME_FEED is not set; runs will not hold off during sequencer downtime")
+	}
+
+	// Rebalance policies: act only on meaningful drift that pays for its own gas
+	policies := []RebalancePolicy{
+		&DriftPolicy{
+			AbsoluteThreshold: cfg.DriftThresholdAmount(),
+			RelativeThreshold: cfg.DriftThreshold,
+		},
+		&TurnoverPolicy{
+			Threshold: cfg.TurnoverThreshold,
+		},
+		&GasCostPolicy{
+			Estimator:     NewChainCostEstimator(contractManager, prices, cfg.ETHPriceUSD),
+			Prices:        prices,
+			Interval:      cfg.RebalanceInterval,
+			MinGainToCost: cfg.MinGainToCost,
+			AssetDecimals: assetDecimals,
+			AssetPriceUSD: 1.0, // Without CHAINLINK_USDC_USD_FEED the asset is assumed to hold its peg
+		},
+	}
+	if cfg.SolverMode == "turnover" {
+		policies = append(policies, &NetBenefitPolicy{
+			MinNetBenefit: cfg.MinNetBenefit,
+			AssetDecimals: assetDecimals,
+		})
+	}
+
+	// Every run is recorded for the API's rebalance history
+	historyStore, err := history.Open(cfg.HistoryDBPath)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to open rebalance history")
+	}
+	defer historyStore.Close()
+
 	// Initialize rebalancer
-	rebalancer := NewRebalancer(contractManager, mlAPIURL, logger)
+	constraints, err := loadConstraints(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Invalid solver configuration")
+	}
+	checkCtx, cancelCheck := context.WithTimeout(context.Background(), 30*time.Second)
+	err = checkSingleStrategy(checkCtx, contractManager, constraints)
+	cancelCheck()
+	if err != nil {
+		logger.WithError(err).Fatal("Invalid solver configuration")
+	}
+	correlations, err := loadCorrelations(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Invalid solver configuration")
+	}
+	allocate, err := buildAllocator(cfg, constraints, correlations, assetDecimals)
+	if err != nil {
+		logger.WithError(err).Fatal("Invalid solver configuration")
+	}
+	allocate = warnUnknownStrategies(allocate, constraints, logger)
+	allocate = adjustReturns(allocate, solver.ReturnModel(cfg.SolverReturnModel), correlations)
+	rebalancer := NewRebalancer(contractManager, mlClient, allocate, policies, prices, cfg.PredictionWindowDays, historyStore, logger)
 
 	// Create context with cancellation
 	ctx, cancel := context.WithCancel(context.Background())
@@ -72,24 +201,18 @@ func main() {
 		cancel()
 	}()
 
+	// Replace keeper transactions that stay pending too long
+	go contractManager.MonitorPendingTransactions(ctx, 30*time.Second)
+
 	// Start the keeper bot
-	if err := runKeeper(ctx, rebalancer); err != nil {
+	if err := runKeeper(ctx, rebalancer, cfg.RebalanceInterval); err != nil {
 		logger.WithError(err).Fatal("Keeper bot failed")
 	}
 
 	logger.Info("Keeper bot stopped gracefully")
 }
 
-func runKeeper(ctx context.Context, rebalancer *Rebalancer) error {
-	// Rebalancing interval from environment or default to 1 hour
-	intervalStr := os.Getenv("REBALANCE_INTERVAL")
-	rebalanceInterval := time.Hour
-	if intervalStr != "" {
-		if duration, err := time.ParseDuration(intervalStr); err == nil {
-			rebalanceInterval = duration
-		}
-	}
-
+func runKeeper(ctx context.Context, rebalancer *Rebalancer, rebalanceInterval time.Duration) error {
 	ticker := time.NewTicker(rebalanceInterval)
 	defer ticker.Stop()
 
@@ -111,3 +234,215 @@ func runKeeper(ctx context.Context, rebalancer *Rebalancer) error {
 		}
 	}
 }
+
+// buildSigner creates the signer selected by KEEPER_SIGNER
+func buildSigner(ctx context.Context, cfg *config.Config) (web3client.Signer, error) {
+	var signer web3client.Signer
+	var err error
+
+	switch cfg.KeeperSigner {
+	case "raw":
+		signer, err = web3client.NewRawKeySigner(cfg.KeeperPrivateKey)
+	case "keystore":
+		signer, err = web3client.NewKeystoreSigner(cfg.KeeperKeystoreFile, cfg.KeeperKeystorePasswordFile)
+	case "remote":
+		signer, err = web3client.NewRemoteSigner(ctx, cfg.KeeperRemoteSignerURL, common.HexToAddress(cfg.KeeperAddress))
+	default:
+		return nil, fmt.Errorf("unknown KEEPER_SIGNER %q (expected raw, keystore or remote)", cfg.KeeperSigner)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.KeeperAddress != "" && signer.Address() != common.HexToAddress(cfg.KeeperAddress) {
+		return nil, fmt.Errorf("signer address %s does not match KEEPER_ADDRESS %s", signer.Address().Hex(), cfg.KeeperAddress)
+	}
+
+	return signer, nil
+}
+
+// marketClient is the RPC backend the market data sources read from
+type marketClient interface {
+	aggregator.ChainCaller
+	aggregator.FeeHistoryClient
+}
+
+// buildDataAggregator registers the fee history source, and the configured Chainlink
+// price feeds and sequencer uptime feed, each on its own
+func buildDataAggregator(cfg *config.Config, client marketClient) (*aggregator.DataAggregator, error) {
+	maxDeviation := cfg.ChainlinkMaxDeviationPercent / 100
+
+	sources := []aggregator.Source{aggregator.NewFeeHistorySource(client)}
+	if cfg.ChainlinkETHUSDFeed != "" {
+		sources = append(sources, aggregator.NewChainlinkSource(client, "chainlink_eth_usd", aggregator.FieldETHPrice, common.HexToAddress(cfg.ChainlinkETHUSDFeed), aggregator.FeedChecks{
+			Heartbeat:    cfg.ChainlinkETHUSDHeartbeat,
+			MaxDeviation: maxDeviation,
+		}))
+	}
+	if cfg.ChainlinkUSDCUSDFeed != "" {
+		sources = append(sources, aggregator.NewChainlinkSource(client, "chainlink_usdc_usd", aggregator.FieldUSDCPrice, common.HexToAddress(cfg.ChainlinkUSDCUSDFeed), aggregator.FeedChecks{
+			Heartbeat:    cfg.ChainlinkUSDCUSDHeartbeat,
+			MaxDeviation: maxDeviation,
+		}))
+	}
+	if cfg.SequencerUptimeFeed != "" {
+		sources = append(sources, aggregator.NewSequencerUptimeSource(client, common.HexToAddress(cfg.SequencerUptimeFeed), cfg.SequencerGracePeriod))
+	}
+	registry := aggregator.NewRegistry()
+	for _, source := range sources {
+		if err := registry.Register(source, cfg.AggregatorSourceTimeout); err != nil {
+			return nil, err
+		}
+	}
+
+	return aggregator.NewDataAggregator(registry), nil
+}
+
+// loadConstraints returns the default constraint set overridden by SOLVER_CONSTRAINTS_FILE
+func loadConstraints(cfg *config.Config) (*solver.ConstraintSet, error) {
+	constraints := solver.DefaultConstraints(cfg.RiskTolerance)
+	if cfg.SolverConstraintsFile != "" {
+		if err := constraints.LoadFile(cfg.SolverConstraintsFile); err != nil {
+			return nil, err
+		}
+	}
+	return constraints, nil
+}
+
+// checkSingleStrategy refuses a controller with one strategy that the constraints cannot
+// give all assets to. Every run would otherwise be skipped as infeasible, since with the
+// default 50% cap a lone strategy can never hold 100%.
+func checkSingleStrategy(ctx context.Context, cm *web3client.ContractManager, constraints *solver.ConstraintSet) error {
+	strategies, err := cm.GetStrategies(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to read strategies: %w", err)
+	}
+	if len(strategies) != 1 {
+		return nil
+	}
+
+	limit, err := cm.GetStrategyAllocationLimit(ctx, strategies[0], nil)
+	if err != nil {
+		return fmt.Errorf("failed to read allocation limit for strategy %s: %w", strategies[0].Hex(), err)
+	}
+	maxAllocation, _ := new(big.Float).Quo(new(big.Float).SetInt(limit), big.NewFloat(basisPoints)).Float64()
+	input := solver.StrategyInput{Name: strategies[0].Hex(), MaxAllocation: maxAllocation}
+	if err := constraints.Validate([]solver.StrategyInput{input}); err != nil {
+		return fmt.Errorf("the controller has a single strategy, which must be allowed to hold all assets (raise max_allocation in SOLVER_CONSTRAINTS_FILE and its on-chain allocation limit to 100%%): %w", err)
+	}
+	return nil
+}
+
+// buildAllocator creates the allocation method selected by SOLVER_MODE, enforcing the
+// given constraint set and estimating risk from the correlations from
+// SOLVER_CORRELATION_FILE, if any. Amounts are in base units of
+// an asset with the given decimals.
+func buildAllocator(cfg *config.Config, constraints *solver.ConstraintSet, correlations config.Correlations, decimals int) (Allocator, error) {
+	optimizer, err := solver.NewConstrainedSolver(constraints)
+	if err != nil {
+		return nil, err
+	}
+	plan := func(results []solver.AllocationResult, err error) (*AllocationPlan, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &AllocationPlan{Results: results}, nil
+	}
+
+	switch cfg.SolverMode {
+	case "score":
+		return func(inputs []solver.StrategyInput, totalAssets float64) (*AllocationPlan, error) {
+			return plan(optimizer.Optimize(inputs, totalAssets))
+		}, nil
+	case "max_sharpe", "min_variance":
+		if correlations == nil {
+			return nil, fmt.Errorf("SOLVER_MODE %s needs strategy correlations in SOLVER_CORRELATION_FILE", cfg.SolverMode)
+		}
+		return func(inputs []solver.StrategyInput, totalAssets float64) (*AllocationPlan, error) {
+			correlation, err := correlationMatrix(correlations, inputs)
+			if err != nil {
+				return nil, err
+			}
+			meanVariance := solver.MeanVarianceConfig{
+				Objective:    solver.Objective(cfg.SolverMode),
+				RiskFreeRate: cfg.SolverRiskFreeRate,
+				TargetReturn: cfg.SolverTargetReturn,
+				Correlation:  correlation,
+			}
+			return plan(optimizer.OptimizeMeanVariance(inputs, totalAssets, meanVariance))
+		}, nil
+	case "inverse_volatility", "equal_risk_contribution", "hrp":
+		// Inverse volatility only reads the variances; the other methods need correlations
+		if correlations == nil && cfg.SolverMode != "inverse_volatility" {
+			return nil, fmt.Errorf("SOLVER_MODE %s needs strategy correlations in SOLVER_CORRELATION_FILE", cfg.SolverMode)
+		}
+		return func(inputs []solver.StrategyInput, totalAssets float64) (*AllocationPlan, error) {
+			correlation, err := correlationMatrix(correlations, inputs)
+			if err != nil {
+				return nil, err
+			}
+			riskParity := solver.RiskParityConfig{
+				Method:      solver.RiskParityMethod(cfg.SolverMode),
+				Correlation: correlation,
+			}
+			return plan(optimizer.OptimizeRiskParity(inputs, totalAssets, riskParity))
+		}, nil
+	case "turnover":
+		scale := math.Pow10(decimals)
+		return func(inputs []solver.StrategyInput, totalAssets float64) (*AllocationPlan, error) {
+			correlation, err := correlationMatrix(correlations, inputs)
+			if err != nil {
+				return nil, err
+			}
+			turnover := solver.TurnoverConfig{
+				Horizon:      cfg.RebalanceInterval,
+				RiskAversion: cfg.TurnoverRiskAversion,
+				MinTradeSize: cfg.TurnoverMinTrade * scale,
+				Correlation:  correlation,
+			}
+			costed := make([]solver.StrategyInput, len(inputs))
+			for i, input := range inputs {
+				input.EntryCost = cfg.TurnoverEntryCostBps / basisPoints
+				input.ExitCost = cfg.TurnoverExitCostBps / basisPoints
+				input.FixedCost = cfg.TurnoverLegCost * scale
+				costed[i] = input
+			}
+
+			result, err := optimizer.OptimizeWithTurnover(costed, totalAssets, turnover)
+			if err != nil {
+				return nil, err
+			}
+			return &AllocationPlan{Results: result.Allocations, Turnover: result}, nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown SOLVER_MODE %q (expected score, max_sharpe, min_variance, inverse_volatility, equal_risk_contribution, hrp or turnover)", cfg.SolverMode)
+	}
+}
+
+// loadCorrelations reads SOLVER_CORRELATION_FILE, returning nil when none is configured
+func loadCorrelations(cfg *config.Config) (config.Correlations, error) {
+	if cfg.SolverCorrelationFile == "" {
+		return nil, nil
+	}
+	return config.LoadCorrelations(cfg.SolverCorrelationFile)
+}
+
+// buildFeeStrategy creates the fee strategy selected by FEE_STRATEGY
+func buildFeeStrategy(cfg *config.Config) (web3client.FeeStrategy, error) {
+	switch cfg.FeeStrategy {
+	case "multiplier":
+		return &web3client.BaseFeeMultiplierStrategy{
+			Multiplier: cfg.GasPriceMultiplier,
+			Tip:        web3client.GweiToWei(cfg.PriorityFeeGwei),
+		}, nil
+	case "percentile":
+		return web3client.NewPercentileTipStrategy(cfg.PriorityFeePercentile, cfg.GasPriceMultiplier)
+	case "fixed":
+		return &web3client.FixedCapStrategy{
+			MaxFeePerGas:         web3client.GweiToWei(cfg.FixedMaxFeeGwei),
+			MaxPriorityFeePerGas: web3client.GweiToWei(cfg.PriorityFeeGwei),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown FEE_STRATEGY %q (expected multiplier, percentile or fixed)", cfg.FeeStrategy)
+	}
+}
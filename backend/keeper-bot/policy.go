@@ -0,0 +1,315 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/aegis-yield/backend/data-aggregator"
+	"github.com/aegis-yield/backend/web3-client"
+)
+
+// secondsPerYear is used to pro-rate annual yields over the rebalance interval
+const secondsPerYear = 365 * 24 * 60 * 60
+
+// RebalanceDecision is the structured outcome of evaluating the rebalance policies
+type RebalanceDecision struct {
+	Rebalance   bool           `json:"rebalance"`
+	Reason      string         `json:"reason"`
+	Checks      []PolicyResult `json:"checks"`
+	EvaluatedAt time.Time      `json:"evaluated_at"`
+}
+
+// PolicyResult is the verdict of a single policy
+type PolicyResult struct {
+	Policy  string             `json:"policy"`
+	Passed  bool               `json:"passed"`
+	Reason  string             `json:"reason"`
+	Metrics map[string]float64 `json:"metrics,omitempty"`
+}
+
+// PolicyInput is the data a policy evaluates
+type PolicyInput struct {
+	Current     *PortfolioState
+	Target      *RebalanceRequest
+	Predictions []MLPrediction
+}
+
+// RebalancePolicy decides whether a proposed rebalance is worth executing
+type RebalancePolicy interface {
+	Name() string
+	Evaluate(ctx context.Context, input *PolicyInput) (*PolicyResult, error)
+}
+
+// evaluatePolicies runs every policy and approves the rebalance only if all of them pass.
+// A policy that fails to evaluate counts as a failed check.
+func evaluatePolicies(ctx context.Context, policies []RebalancePolicy, input *PolicyInput) *RebalanceDecision {
+	decision := &RebalanceDecision{
+		Rebalance:   true,
+		Checks:      make([]PolicyResult, 0, len(policies)),
+		EvaluatedAt: time.Now().UTC(),
+	}
+
+	var failed []string
+	for _, policy := range policies {
+		result, err := policy.Evaluate(ctx, input)
+		if err != nil {
+			result = &PolicyResult{
+				Policy: policy.Name(),
+				Reason: fmt.Sprintf("evaluation failed: %v", err),
+			}
+		}
+
+		decision.Checks = append(decision.Checks, *result)
+		if !result.Passed {
+			decision.Rebalance = false
+			failed = append(failed, fmt.Sprintf("%s: %s", result.Policy, result.Reason))
+		}
+	}
+
+	if decision.Rebalance {
+		decision.Reason = fmt.Sprintf("all %d policies passed", len(policies))
+	} else {
+		decision.Reason = strings.Join(failed, "; ")
+	}
+
+	return decision
+}
+
+// targetByStrategy pairs each current strategy amount with its proposed target
+func targetByStrategy(input *PolicyInput) ([]*big.Int, []*big.Int) {
+	targets := make(map[string]*big.Int, len(input.Target.StrategyIDs))
+	for i, strategy := range input.Target.StrategyIDs {
+		targets[strategy.Hex()] = input.Target.TargetAmounts[i]
+	}
+
+	current := make([]*big.Int, 0, len(input.Current.Strategies))
+	target := make([]*big.Int, 0, len(input.Current.Strategies))
+	for _, strategy := range input.Current.Strategies {
+		amount, ok := targets[strategy.Address.Hex()]
+		if !ok {
+			amount = strategy.CurrentAmount
+		}
+		current = append(current, strategy.CurrentAmount)
+		target = append(target, amount)
+	}
+
+	return current, target
+}
+
+// fraction returns amount / total as a float
+func fraction(amount, total *big.Int) float64 {
+	if total.Sign() == 0 {
+		return 0
+	}
+	f, _ := new(big.Rat).SetFrac(amount, total).Float64()
+	return f
+}
+
+// DriftPolicy passes when any single strategy drifts from its target by more than
+// an absolute amount (asset base units) or a fraction of total assets.
+// A zero threshold disables that check.
+type DriftPolicy struct {
+	AbsoluteThreshold *big.Int
+	RelativeThreshold float64
+}
+
+// Name implements RebalancePolicy
+func (p *DriftPolicy) Name() string { return "drift" }
+
+// Evaluate implements RebalancePolicy
+func (p *DriftPolicy) Evaluate(ctx context.Context, input *PolicyInput) (*PolicyResult, error) {
+	current, target := targetByStrategy(input)
+
+	maxDrift := new(big.Int)
+	for i := range current {
+		drift := new(big.Int).Sub(target[i], current[i])
+		drift.Abs(drift)
+		if drift.Cmp(maxDrift) > 0 {
+			maxDrift = drift
+		}
+	}
+
+	maxRelative := fraction(maxDrift, input.Current.TotalAssets)
+	maxAbsolute, _ := new(big.Float).SetInt(maxDrift).Float64()
+
+	result := &PolicyResult{
+		Policy: p.Name(),
+		Metrics: map[string]float64{
+			"max_drift":          maxAbsolute,
+			"max_relative_drift": maxRelative,
+		},
+	}
+
+	switch {
+	case p.AbsoluteThreshold != nil && p.AbsoluteThreshold.Sign() > 0 && maxDrift.Cmp(p.AbsoluteThreshold) >= 0:
+		result.Passed = true
+		result.Reason = fmt.Sprintf("max drift %s reaches absolute threshold %s", maxDrift, p.AbsoluteThreshold)
+	case p.RelativeThreshold > 0 && maxRelative >= p.RelativeThreshold:
+		result.Passed = true
+		result.Reason = fmt.Sprintf("max drift %.4f of total assets reaches relative threshold %.4f", maxRelative, p.RelativeThreshold)
+	default:
+		result.Reason = fmt.Sprintf("max drift %.4f of total assets is below thresholds", maxRelative)
+	}
+
+	return result, nil
+}
+
+// TurnoverPolicy passes when the portfolio-level L1 turnover, sum(|target - current|) / total,
+// reaches the threshold.
+type TurnoverPolicy struct {
+	Threshold float64
+}
+
+// Name implements RebalancePolicy
+func (p *TurnoverPolicy) Name() string { return "turnover" }
+
+// Evaluate implements RebalancePolicy
+func (p *TurnoverPolicy) Evaluate(ctx context.Context, input *PolicyInput) (*PolicyResult, error) {
+	current, target := targetByStrategy(input)
+
+	moved := new(big.Int)
+	for i := range current {
+		diff := new(big.Int).Sub(target[i], current[i])
+		moved.Add(moved, diff.Abs(diff))
+	}
+	turnover := fraction(moved, input.Current.TotalAssets)
+
+	result := &PolicyResult{
+		Policy:  p.Name(),
+		Passed:  turnover >= p.Threshold,
+		Metrics: map[string]float64{"turnover": turnover},
+	}
+	if result.Passed {
+		result.Reason = fmt.Sprintf("turnover %.4f reaches threshold %.4f", turnover, p.Threshold)
+	} else {
+		result.Reason = fmt.Sprintf("turnover %.4f is below threshold %.4f", turnover, p.Threshold)
+	}
+
+	return result, nil
+}
+
+// NetBenefitPolicy passes when the solver expects the rebalance to earn more over its
+// horizon than the entry, exit and per-leg costs it models, by at least MinNetBenefit
+// (asset units). It applies only to allocation modes that estimate trading costs.
+type NetBenefitPolicy struct {
+	MinNetBenefit float64
+	AssetDecimals int
+}
+
+// Name implements RebalancePolicy
+func (p *NetBenefitPolicy) Name() string { return "net_benefit" }
+
+// Evaluate implements RebalancePolicy
+func (p *NetBenefitPolicy) Evaluate(ctx context.Context, input *PolicyInput) (*PolicyResult, error) {
+	estimate := input.Target.Turnover
+	if estimate == nil {
+		return &PolicyResult{
+			Policy: p.Name(),
+			Passed: true,
+			Reason: "allocation mode does not estimate trading costs",
+		}, nil
+	}
+
+	scale := math.Pow10(p.AssetDecimals)
+	netBenefit := estimate.NetBenefit / scale
+
+	result := &PolicyResult{
+		Policy: p.Name(),
+		Metrics: map[string]float64{
+			"expected_gain": estimate.ExpectedGain / scale,
+			"trading_cost":  estimate.TradingCost / scale,
+			"net_benefit":   netBenefit,
+			"legs":          float64(estimate.Legs),
+		},
+	}
+
+	if estimate.Legs > 0 && netBenefit >= p.MinNetBenefit {
+		result.Passed = true
+		result.Reason = fmt.Sprintf("expected net benefit %.2f across %d legs reaches minimum %.2f", netBenefit, estimate.Legs, p.MinNetBenefit)
+	} else {
+		result.Reason = fmt.Sprintf("expected net benefit %.2f across %d legs is below minimum %.2f", netBenefit, estimate.Legs, p.MinNetBenefit)
+	}
+
+	return result, nil
+}
+
+// CostEstimator estimates the cost of executing a rebalance
+type CostEstimator interface {
+	EstimateRebalanceCost(ctx context.Context, req *RebalanceRequest) (*web3client.CostEstimate, error)
+}
+
+// GasCostPolicy passes when the expected yield improvement over the rebalance interval
+// exceeds the estimated transaction cost by at least MinGainToCost. The gain is valued
+// at the USDC/USD price reported by Prices, or at the fixed AssetPriceUSD when Prices
+// has no USDC/USD source.
+type GasCostPolicy struct {
+	Estimator     CostEstimator
+	Prices        *aggregator.DataAggregator
+	Interval      time.Duration
+	MinGainToCost float64
+	AssetDecimals int
+	AssetPriceUSD float64
+}
+
+// Name implements RebalancePolicy
+func (p *GasCostPolicy) Name() string { return "gas_cost" }
+
+// Evaluate implements RebalancePolicy
+func (p *GasCostPolicy) Evaluate(ctx context.Context, input *PolicyInput) (*PolicyResult, error) {
+	apys := make(map[string]float64, len(input.Predictions))
+	for _, prediction := range input.Predictions {
+		apys[prediction.StrategyAddress.Hex()] = prediction.PredictedAPY
+	}
+
+	current, target := targetByStrategy(input)
+
+	// Annual yield change in asset base units: sum((target - current) * predicted APY)
+	annualGain := 0.0
+	for i, strategy := range input.Current.Strategies {
+		diff, _ := new(big.Float).SetInt(new(big.Int).Sub(target[i], current[i])).Float64()
+		annualGain += diff * apys[strategy.Address.Hex()]
+	}
+
+	assetPriceUSD := p.AssetPriceUSD
+	if p.Prices.Provides(aggregator.FieldUSDCPrice) {
+		price, err := p.Prices.USDCPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get USDC price: %w", err)
+		}
+		assetPriceUSD = price.Value
+	}
+
+	periodGain := annualGain * p.Interval.Seconds() / secondsPerYear
+	gainUSD := periodGain / math.Pow10(p.AssetDecimals) * assetPriceUSD
+
+	cost, err := p.Estimator.EstimateRebalanceCost(ctx, input.Target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate rebalance cost: %w", err)
+	}
+
+	result := &PolicyResult{
+		Policy: p.Name(),
+		Metrics: map[string]float64{
+			"expected_gain_usd":  gainUSD,
+			"asset_price_usd":    assetPriceUSD,
+			"estimated_cost_usd": cost.TotalUSD,
+			"l1_fee_usd":         cost.L1FeeUSD,
+			"l2_fee_usd":         cost.L2FeeUSD,
+			"gas_limit":          float64(cost.GasLimit),
+		},
+	}
+
+	required := cost.TotalUSD * p.MinGainToCost
+	if gainUSD > 0 && gainUSD >= required {
+		result.Passed = true
+		result.Reason = fmt.Sprintf("expected gain $%.2f over %s covers cost $%.2f", gainUSD, p.Interval, cost.TotalUSD)
+	} else {
+		result.Reason = fmt.Sprintf("expected gain $%.2f over %s does not cover cost $%.2f", gainUSD, p.Interval, cost.TotalUSD)
+	}
+
+	return result, nil
+}
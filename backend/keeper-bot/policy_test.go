@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/aegis-yield/backend/data-aggregator"
+	"github.com/aegis-yield/backend/optimization-solver"
+	"github.com/aegis-yield/backend/web3-client"
+)
+
+var (
+	strategyA = common.HexToAddress("0xa")
+	strategyB = common.HexToAddress("0xb")
+)
+
+// policyInput moves 100 of 1,000 USDC from strategy a to strategy b, a 10% drift and
+// 20% turnover. With a at 2% and b at 12% APY, the move earns 10 USDC a year.
+func policyInput() *PolicyInput {
+	usdc := func(amount int64) *big.Int { return big.NewInt(amount * 1_000_000) }
+	return &PolicyInput{
+		Current: &PortfolioState{
+			TotalAssets: usdc(1000),
+			Strategies: []StrategyInfo{
+				{Address: strategyA, CurrentAmount: usdc(600)},
+				{Address: strategyB, CurrentAmount: usdc(400)},
+			},
+		},
+		Target: &RebalanceRequest{
+			StrategyIDs:   []common.Address{strategyA, strategyB},
+			TargetAmounts: []*big.Int{usdc(500), usdc(500)},
+		},
+		Predictions: []MLPrediction{
+			{StrategyAddress: strategyA, PredictedAPY: 0.02},
+			{StrategyAddress: strategyB, PredictedAPY: 0.12},
+		},
+	}
+}
+
+// fixedCost estimates every rebalance at the same USD cost
+type fixedCost struct {
+	usd float64
+	err error
+}
+
+func (c *fixedCost) EstimateRebalanceCost(ctx context.Context, req *RebalanceRequest) (*web3client.CostEstimate, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &web3client.CostEstimate{TotalUSD: c.usd}, nil
+}
+
+// usdcFeed reports a USDC/USD price
+type usdcFeed float64
+
+func (f usdcFeed) Name() string       { return "usdc_feed" }
+func (f usdcFeed) Provides() []string { return []string{aggregator.FieldUSDCPrice} }
+
+func (f usdcFeed) Fetch(ctx context.Context) ([]aggregator.Observation, error) {
+	return []aggregator.Observation{{
+		Field:      aggregator.FieldUSDCPrice,
+		Value:      float64(f),
+		Provenance: aggregator.Provenance{Source: f.Name()},
+	}}, nil
+}
+
+func usdcPrices(t *testing.T, price float64) *aggregator.DataAggregator {
+	t.Helper()
+	registry := aggregator.NewRegistry()
+	if err := registry.Register(usdcFeed(price), time.Second); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	return aggregator.NewDataAggregator(registry)
+}
+
+func TestRebalancePolicies(t *testing.T) {
+	year := 365 * 24 * time.Hour
+
+	tests := []struct {
+		name    string
+		policy  RebalancePolicy
+		input   func(*PolicyInput)
+		passed  bool
+		wantErr bool
+	}{
+		{"drift reaches absolute threshold", &DriftPolicy{AbsoluteThreshold: big.NewInt(100_000_000)}, nil, true, false},
+		{"drift below absolute threshold", &DriftPolicy{AbsoluteThreshold: big.NewInt(100_000_001)}, nil, false, false},
+		{"drift reaches relative threshold", &DriftPolicy{AbsoluteThreshold: big.NewInt(0), RelativeThreshold: 0.1}, nil, true, false},
+		{"drift below relative threshold", &DriftPolicy{RelativeThreshold: 0.11}, nil, false, false},
+		{"drift without thresholds", &DriftPolicy{}, nil, false, false},
+
+		{"turnover reaches threshold", &TurnoverPolicy{Threshold: 0.2}, nil, true, false},
+		{"turnover below threshold", &TurnoverPolicy{Threshold: 0.21}, nil, false, false},
+
+		{
+			name:   "net benefit reaches minimum",
+			policy: &NetBenefitPolicy{MinNetBenefit: 5, AssetDecimals: 6},
+			input: func(in *PolicyInput) {
+				in.Target.Turnover = &solver.TurnoverResult{Legs: 2, ExpectedGain: 8e6, TradingCost: 2e6, NetBenefit: 6e6}
+			},
+			passed: true,
+		},
+		{
+			name:   "net benefit below minimum",
+			policy: &NetBenefitPolicy{MinNetBenefit: 5, AssetDecimals: 6},
+			input: func(in *PolicyInput) {
+				in.Target.Turnover = &solver.TurnoverResult{Legs: 2, ExpectedGain: 6e6, TradingCost: 2e6, NetBenefit: 4e6}
+			},
+		},
+		{
+			name:   "net benefit without trades",
+			policy: &NetBenefitPolicy{AssetDecimals: 6},
+			input:  func(in *PolicyInput) { in.Target.Turnover = &solver.TurnoverResult{} },
+		},
+		{"net benefit not estimated", &NetBenefitPolicy{MinNetBenefit: 5, AssetDecimals: 6}, nil, true, false},
+
+		{
+			name:   "gain covers gas",
+			policy: &GasCostPolicy{Estimator: &fixedCost{usd: 5}, Interval: year, MinGainToCost: 2, AssetDecimals: 6, AssetPriceUSD: 1},
+			passed: true,
+		},
+		{
+			name:   "gain does not cover gas",
+			policy: &GasCostPolicy{Estimator: &fixedCost{usd: 5}, Interval: year, MinGainToCost: 2.5, AssetDecimals: 6, AssetPriceUSD: 1},
+		},
+		{
+			name:   "gain valued at the feed price",
+			policy: &GasCostPolicy{Estimator: &fixedCost{usd: 5}, Prices: usdcPrices(t, 0.4), Interval: year, MinGainToCost: 1, AssetDecimals: 6, AssetPriceUSD: 1},
+		},
+		{
+			name:   "losing move",
+			policy: &GasCostPolicy{Estimator: &fixedCost{}, Interval: year, AssetDecimals: 6, AssetPriceUSD: 1},
+			input: func(in *PolicyInput) {
+				in.Predictions[0].PredictedAPY, in.Predictions[1].PredictedAPY = 0.12, 0.02
+			},
+		},
+		{
+			name:    "gas estimate fails",
+			policy:  &GasCostPolicy{Estimator: &fixedCost{err: errors.New("execution reverted")}, Interval: year, AssetDecimals: 6, AssetPriceUSD: 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := policyInput()
+			if tt.input != nil {
+				tt.input(input)
+			}
+
+			result, err := tt.policy.Evaluate(context.Background(), input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Evaluate = %+v, want an error", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Evaluate: %v", err)
+			}
+			if result.Passed != tt.passed || result.Policy != tt.policy.Name() || result.Reason == "" {
+				t.Errorf("result = %+v, want passed %v with a reason", result, tt.passed)
+			}
+		})
+	}
+}
+
+func TestGasCostPolicyValuesGainAtFeedPrice(t *testing.T) {
+	policy := &GasCostPolicy{
+		Estimator:     &fixedCost{usd: 1},
+		Prices:        usdcPrices(t, 0.998),
+		Interval:      365 * 24 * time.Hour,
+		AssetDecimals: 6,
+		AssetPriceUSD: 1,
+	}
+
+	result, err := policy.Evaluate(context.Background(), policyInput())
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if price := result.Metrics["asset_price_usd"]; price != 0.998 {
+		t.Errorf("asset price = %v, want the feed's 0.998", price)
+	}
+	if gain := result.Metrics["expected_gain_usd"]; gain < 9.979 || gain > 9.981 {
+		t.Errorf("expected gain = $%v, want 10 USDC at $0.998", gain)
+	}
+}
+
+func TestEvaluatePoliciesRequiresEveryPolicy(t *testing.T) {
+	policies := []RebalancePolicy{
+		&DriftPolicy{RelativeThreshold: 0.05},
+		&TurnoverPolicy{Threshold: 0.5},
+		&GasCostPolicy{Estimator: &fixedCost{err: errors.New("rpc down")}, AssetDecimals: 6},
+	}
+
+	decision := evaluatePolicies(context.Background(), policies, policyInput())
+	if decision.Rebalance {
+		t.Fatal("rebalance approved with failing policies")
+	}
+	if len(decision.Checks) != 3 || !decision.Checks[0].Passed || decision.Checks[1].Passed || decision.Checks[2].Passed {
+		t.Errorf("checks = %+v, want drift passed, turnover and gas cost failed", decision.Checks)
+	}
+
+	decision = evaluatePolicies(context.Background(), policies[:1], policyInput())
+	if !decision.Rebalance {
+		t.Errorf("decision = %+v, want approval when every policy passes", decision)
+	}
+}
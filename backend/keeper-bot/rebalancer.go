@@ -2,72 +2,166 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/sirupsen/logrus"
-	
-	// Import generated bindings (will be created by generate-bindings.sh)
-	// "github.com/aegis-yield/backend/web3-client/bindings"
+
+	"github.com/aegis-yield/backend/data-aggregator"
+	"github.com/aegis-yield/backend/ml-client"
+	"github.com/aegis-yield/backend/optimization-solver"
+	"github.com/aegis-yield/backend/pkg/history"
 	"github.com/aegis-yield/backend/web3-client"
 )
 
 // Rebalancer handles the rebalancing logic
 type Rebalancer struct {
 	contractManager *web3client.ContractManager
-	mlAPIURL        string
+	mlClient        *mlclient.Client
+	allocate        Allocator
+	policies        []RebalancePolicy
+	market          *aggregator.DataAggregator
+	horizonDays     int
+	history         *history.Store
 	logger          *logrus.Logger
+
+	mu           sync.RWMutex
+	lastDecision *RebalanceDecision
 }
 
 // NewRebalancer creates a new rebalancer instance
-func NewRebalancer(cm *web3client.ContractManager, mlAPIURL string, logger *logrus.Logger) *Rebalancer {
+func NewRebalancer(cm *web3client.ContractManager, mlClient *mlclient.Client, allocate Allocator, policies []RebalancePolicy, market *aggregator.DataAggregator, horizonDays int, store *history.Store, logger *logrus.Logger) *Rebalancer {
 	return &Rebalancer{
 		contractManager: cm,
-		mlAPIURL:        mlAPIURL,
+		mlClient:        mlClient,
+		allocate:        allocate,
+		policies:        policies,
+		market:          market,
+		horizonDays:     horizonDays,
+		history:         store,
 		logger:          logger,
 	}
 }
 
 // RebalanceRequest contains the rebalancing parameters
 type RebalanceRequest struct {
-	StrategyIDs    []common.Address
-	TargetAmounts  []*big.Int
-	BridgeCallData [][]byte
+	StrategyIDs   []common.Address
+	TargetAmounts []*big.Int
+	// Turnover is the solver's estimate of the trade's net benefit, when available
+	Turnover *solver.TurnoverResult
+}
+
+// Targets returns the request as controller TargetAllocation structs
+func (req *RebalanceRequest) Targets() []web3client.TargetAllocation {
+	targets := make([]web3client.TargetAllocation, len(req.StrategyIDs))
+	for i, strategy := range req.StrategyIDs {
+		targets[i] = web3client.TargetAllocation{
+			Strategy:     strategy,
+			TargetAmount: req.TargetAmounts[i],
+		}
+	}
+	return targets
+}
+
+// LastDecision returns the most recent rebalance decision, if any
+func (r *Rebalancer) LastDecision() *RebalanceDecision {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.lastDecision
 }
 
-// ExecuteRebalance performs the full rebalancing workflow
+// ExecuteRebalance performs the full rebalancing workflow and records the run in the
+// rebalance history
 func (r *Rebalancer) ExecuteRebalance(ctx context.Context) error {
+	record := &history.Record{StartedAt: time.Now().UTC()}
+
+	err := r.rebalance(ctx, record)
+
+	record.FinishedAt = time.Now().UTC()
+	switch {
+	case err != nil:
+		record.Status = history.StatusFailed
+		record.Reason = err.Error()
+	case record.Status == "":
+		record.Status = history.StatusSucceeded
+	}
+	r.saveRecord(record)
+
+	return err
+}
+
+// rebalance runs the workflow, filling in record as it goes. Skipped runs set the
+// record's status and reason and return nil.
+func (r *Rebalancer) rebalance(ctx context.Context, record *history.Record) error {
 	r.logger.Info("Starting rebalance workflow...")
 
+	// Step 0: Refuse to act on stale or anomalous oracle data
+	market, unsafe, err := r.checkMarketData(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch market data: %w", err)
+	} else if unsafe != nil {
+		r.logger.WithFields(logrus.Fields{
+			"source": unsafe.Source,
+			"reason": unsafe.Reason,
+		}).Warn("Skipping rebalance: " + unsafe.Detail)
+		record.Inputs = r.encodeInputs(&rebalanceInputs{Market: market})
+		skip(record, fmt.Sprintf("unsafe market data from %s: %s", unsafe.Source, unsafe.Error()))
+		return nil
+	}
+
 	// Step 1: Fetch current portfolio state from blockchain
 	portfolioState, err := r.fetchPortfolioState(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to fetch portfolio state: %w", err)
 	}
+	record.Inputs = r.encodeInputs(&rebalanceInputs{Portfolio: portfolioState, Market: market})
 
 	r.logger.WithFields(logrus.Fields{
 		"totalAssets":      portfolioState.TotalAssets,
 		"activeStrategies": len(portfolioState.Strategies),
 	}).Info("Current portfolio state fetched")
 
+	if len(portfolioState.Strategies) == 0 || portfolioState.TotalAssets.Sign() == 0 {
+		r.logger.Info("No strategies or assets to allocate, skipping rebalance")
+		skip(record, "no strategies or assets to allocate")
+		return nil
+	}
+
 	// Step 2: Query ML engine for predictions
 	predictions, err := r.queryMLEngine(ctx, portfolioState)
 	if err != nil {
 		return fmt.Errorf("failed to query ML engine: %w", err)
 	}
+	record.Inputs = r.encodeInputs(&rebalanceInputs{Portfolio: portfolioState, Market: market, Predictions: predictions})
 
 	r.logger.WithField("predictions", predictions).Info("ML predictions received")
 
 	// Step 3: Run optimization solver
 	rebalanceReq, err := r.runOptimizationSolver(portfolioState, predictions)
 	if err != nil {
+		var infeasible *solver.InfeasibleError
+		if errors.As(err, &infeasible) {
+			r.logger.WithField("reason", err.Error()).Warn("Skipping rebalance: allocation constraints cannot be met")
+			skip(record, err.Error())
+			return nil
+		}
 		return fmt.Errorf("failed to run optimization: %w", err)
 	}
+	record.Targets = targetAllocations(portfolioState, rebalanceReq)
 
 	// Step 4: Check if rebalancing is needed
-	if !r.shouldRebalance(portfolioState, rebalanceReq) {
-		r.logger.Info("No rebalancing needed, portfolio is optimal")
+	decision := r.shouldRebalance(ctx, portfolioState, rebalanceReq, predictions)
+	if encoded, err := json.Marshal(decision); err == nil {
+		record.Decision = encoded
+	}
+	if !decision.Rebalance {
+		r.logger.WithField("reason", decision.Reason).Info("No rebalancing needed")
+		skip(record, decision.Reason)
 		return nil
 	}
 
@@ -77,7 +171,13 @@ func (r *Rebalancer) ExecuteRebalance(ctx context.Context) error {
 	}).Info("Rebalancing required, executing transaction...")
 
 	// Step 5: Execute rebalance transaction
-	if err := r.executeRebalanceTransaction(ctx, rebalanceReq); err != nil {
+	if err := r.executeRebalanceTransaction(ctx, rebalanceReq, record); err != nil {
+		var ceilingErr *web3client.FeeCeilingError
+		if errors.As(err, &ceilingErr) {
+			r.logger.WithField("reason", ceilingErr.Error()).Warn("Skipping rebalance: gas fees above configured ceiling")
+			skip(record, ceilingErr.Error())
+			return nil
+		}
 		return fmt.Errorf("failed to execute rebalance: %w", err)
 	}
 
@@ -85,137 +185,348 @@ func (r *Rebalancer) ExecuteRebalance(ctx context.Context) error {
 	return nil
 }
 
+// checkMarketData fetches market data and returns it with the first source that
+// rejected its data as unsafe. Other fetch failures are returned as errors.
+func (r *Rebalancer) checkMarketData(ctx context.Context) (*aggregator.MarketData, *aggregator.UnsafeDataError, error) {
+	if r.market == nil {
+		return nil, nil, nil
+	}
+
+	market, err := r.market.FetchMarketData(ctx)
+	var unsafe *aggregator.UnsafeDataError
+	if errors.As(err, &unsafe) {
+		return market, unsafe, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return market, nil, nil
+}
+
+func skip(record *history.Record, reason string) {
+	record.Status = history.StatusSkipped
+	record.Reason = reason
+}
+
+// saveRecord writes a run to the rebalance history. A failed write is logged and does
+// not fail the run.
+func (r *Rebalancer) saveRecord(record *history.Record) {
+	if r.history == nil {
+		return
+	}
+
+	// The run's context may already be cancelled on shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := r.history.Save(ctx, record); err != nil {
+		r.logger.WithError(err).Error("Failed to record rebalance history")
+		return
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"id":     record.ID,
+		"status": record.Status,
+	}).Debug("Rebalance recorded")
+}
+
+// rebalanceInputs is the data a rebalance run was based on, as stored in its history record
+type rebalanceInputs struct {
+	Portfolio   *PortfolioState        `json:"portfolio"`
+	Market      *aggregator.MarketData `json:"market,omitempty"`
+	Predictions []MLPrediction         `json:"predictions,omitempty"`
+}
+
+func (r *Rebalancer) encodeInputs(inputs *rebalanceInputs) json.RawMessage {
+	encoded, err := json.Marshal(inputs)
+	if err != nil {
+		r.logger.WithError(err).Warn("Failed to encode rebalance inputs")
+		return nil
+	}
+	return encoded
+}
+
+// targetAllocations pairs each strategy's current amount with its target
+func targetAllocations(state *PortfolioState, req *RebalanceRequest) []history.Allocation {
+	byAddress := make(map[common.Address]StrategyInfo, len(state.Strategies))
+	for _, strategy := range state.Strategies {
+		byAddress[strategy.Address] = strategy
+	}
+
+	allocations := make([]history.Allocation, len(req.StrategyIDs))
+	for i, address := range req.StrategyIDs {
+		current := "0"
+		strategy, ok := byAddress[address]
+		if ok && strategy.CurrentAmount != nil {
+			current = strategy.CurrentAmount.String()
+		}
+		allocations[i] = history.Allocation{
+			Strategy:      address.Hex(),
+			Name:          strategy.Name,
+			CurrentAmount: current,
+			TargetAmount:  req.TargetAmounts[i].String(),
+		}
+	}
+	return allocations
+}
+
 // PortfolioState represents the current state of the portfolio
 type PortfolioState struct {
-	TotalAssets *big.Int
-	Strategies  []StrategyInfo
+	BlockNumber *big.Int       `json:"block_number"` // Block every value was read at
+	TotalAssets *big.Int       `json:"total_assets"`
+	Strategies  []StrategyInfo `json:"strategies"`
 }
 
 // StrategyInfo contains information about a strategy
 type StrategyInfo struct {
-	Address       common.Address
-	CurrentAmount *big.Int
-	APY           *big.Int
-	RiskScore     *big.Int
+	Address         common.Address `json:"address"`
+	Name            string         `json:"name"`             // On-chain name(), used to key ML predictions
+	CurrentAmount   *big.Int       `json:"current_amount"`   // Amount allocated by the controller, in asset base units
+	AllocationLimit *big.Int       `json:"allocation_limit"` // Maximum allocation in basis points
+	APY             *big.Int       `json:"apy_bps"`          // Current APY in basis points
+	RiskScore       *big.Int       `json:"risk_score"`       // Risk score (0-100)
 }
 
 // MLPrediction contains ML engine predictions
 type MLPrediction struct {
-	StrategyAddress common.Address
-	PredictedAPY    float64
-	PredictedVol    float64
-	Confidence      float64
+	StrategyAddress common.Address `json:"strategy"`
+	PredictedAPY    float64        `json:"predicted_apy"`        // Fraction, e.g. 0.052 = 5.2%
+	PredictedVol    float64        `json:"predicted_volatility"` // Fraction
+	Confidence      float64        `json:"confidence"`           // 0-1
+
+	// Prediction interval of the APY, when the ML engine provides one
+	APYLower *float64 `json:"predicted_apy_lower,omitempty"`
+	APYUpper *float64 `json:"predicted_apy_upper,omitempty"`
 }
 
 // fetchPortfolioState retrieves current portfolio state from blockchain
 func (r *Rebalancer) fetchPortfolioState(ctx context.Context) (*PortfolioState, error) {
-	// TODO: Implement using generated contract bindings
-	// Example:
-	// controller, err := bindings.NewAegisController(r.contractManager.GetControllerAddress(), r.contractManager.GetClient())
-	// if err != nil {
-	//     return nil, err
-	// }
-	// totalAssets, err := controller.TotalAssets(nil)
-	// strategies, err := controller.GetActiveStrategies(nil)
-	
-	r.logger.Info("Fetching portfolio state from blockchain...")
-	
-	// Placeholder implementation
-	return &PortfolioState{
-		TotalAssets: big.NewInt(1000000), // $1M
-		Strategies: []StrategyInfo{
-			{
-				Address:       common.HexToAddress("0x1234..."),
-				CurrentAmount: big.NewInt(500000),
-				APY:           big.NewInt(500), // 5%
-				RiskScore:     big.NewInt(300), // 3%
-			},
-		},
+	// Read everything at one block so a deposit or rebalance landing mid-fetch
+	// cannot leave total assets and strategy allocations out of step
+	blockNumber, err := r.contractManager.ReadBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"controller":  r.contractManager.GetControllerAddress().Hex(),
+		"blockNumber": blockNumber,
+	}).Info("Fetching portfolio state from blockchain...")
+
+	totalAssets, err := r.contractManager.GetTotalAssets(ctx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read total assets: %w", err)
+	}
+
+	strategies, err := r.contractManager.GetStrategies(ctx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read strategies: %w", err)
+	}
+
+	state := &PortfolioState{
+		BlockNumber: blockNumber,
+		TotalAssets: totalAssets,
+		Strategies:  make([]StrategyInfo, 0, len(strategies)),
+	}
+
+	for _, strategy := range strategies {
+		info, err := r.fetchStrategyInfo(ctx, strategy, blockNumber)
+		if err != nil {
+			return nil, err
+		}
+		state.Strategies = append(state.Strategies, *info)
+	}
+
+	return state, nil
+}
+
+// fetchStrategyInfo reads the controller allocation and strategy metrics for a single strategy
+func (r *Rebalancer) fetchStrategyInfo(ctx context.Context, strategy common.Address, blockNumber *big.Int) (*StrategyInfo, error) {
+	name, err := r.contractManager.GetStrategyName(ctx, strategy, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read name for strategy %s: %w", strategy.Hex(), err)
+	}
+
+	allocation, err := r.contractManager.GetStrategyAllocation(ctx, strategy, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read allocation for strategy %s: %w", strategy.Hex(), err)
+	}
+
+	allocationLimit, err := r.contractManager.GetStrategyAllocationLimit(ctx, strategy, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read allocation limit for strategy %s: %w", strategy.Hex(), err)
+	}
+
+	apy, err := r.contractManager.GetStrategyAPY(ctx, strategy, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read APY for strategy %s: %w", strategy.Hex(), err)
+	}
+
+	riskScore, err := r.contractManager.GetStrategyRiskScore(ctx, strategy, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read risk score for strategy %s: %w", strategy.Hex(), err)
+	}
+
+	return &StrategyInfo{
+		Address:         strategy,
+		Name:            name,
+		CurrentAmount:   allocation,
+		AllocationLimit: allocationLimit,
+		APY:             apy,
+		RiskScore:       riskScore,
 	}, nil
 }
 
 // queryMLEngine queries the ML API for predictions
 func (r *Rebalancer) queryMLEngine(ctx context.Context, state *PortfolioState) ([]MLPrediction, error) {
-	// TODO: Implement HTTP request to ML API
-	// POST to r.mlAPIURL/predict with portfolio state
-	
 	r.logger.Info("Querying ML engine for predictions...")
-	
-	// Placeholder implementation
-	return []MLPrediction{
-		{
-			StrategyAddress: common.HexToAddress("0x1234..."),
-			PredictedAPY:    5.2,
-			PredictedVol:    3.1,
-			Confidence:      0.85,
-		},
-	}, nil
+
+	if len(state.Strategies) == 0 {
+		return nil, nil
+	}
+
+	// The ML engine keys predictions by strategy name, so names must be unique
+	names := make([]string, 0, len(state.Strategies))
+	seen := make(map[string]common.Address, len(state.Strategies))
+	for _, strategy := range state.Strategies {
+		if other, ok := seen[strategy.Name]; ok {
+			return nil, fmt.Errorf("strategies %s and %s share the name %q", other.Hex(), strategy.Address.Hex(), strategy.Name)
+		}
+		seen[strategy.Name] = strategy.Address
+		names = append(names, strategy.Name)
+	}
+
+	resp, err := r.mlClient.Predict(ctx, &mlclient.PredictRequest{
+		Strategies:  names,
+		HorizonDays: r.horizonDays,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	predictions := make([]MLPrediction, 0, len(state.Strategies))
+	for _, strategy := range state.Strategies {
+		prediction := resp.Predictions[strategy.Name]
+		predictions = append(predictions, MLPrediction{
+			StrategyAddress: strategy.Address,
+			PredictedAPY:    prediction.APY,
+			PredictedVol:    prediction.Volatility,
+			Confidence:      prediction.Confidence,
+			APYLower:        prediction.APYLower,
+			APYUpper:        prediction.APYUpper,
+		})
+	}
+
+	return predictions, nil
 }
 
 // runOptimizationSolver runs the portfolio optimization algorithm
 func (r *Rebalancer) runOptimizationSolver(state *PortfolioState, predictions []MLPrediction) (*RebalanceRequest, error) {
-	// TODO: Implement optimization logic
-	// This should call the optimization-solver package
-	
 	r.logger.Info("Running optimization solver...")
-	
-	// Placeholder implementation
-	return &RebalanceRequest{
-		StrategyIDs: []common.Address{
-			common.HexToAddress("0x1234..."),
-		},
-		TargetAmounts: []*big.Int{
-			big.NewInt(600000), // Increase allocation
-		},
-		BridgeCallData: [][]byte{
-			{}, // No bridge call needed
-		},
-	}, nil
+
+	inputs, err := buildSolverInputs(state, predictions)
+	if err != nil {
+		return nil, err
+	}
+
+	totalAssets, _ := new(big.Float).SetInt(state.TotalAssets).Float64()
+	plan, err := r.allocate(inputs, totalAssets)
+	if err != nil {
+		return nil, err
+	}
+	results := plan.Results
+
+	limits := make(map[common.Address]*big.Int, len(state.Strategies))
+	for _, strategy := range state.Strategies {
+		limits[strategy.Address] = strategy.AllocationLimit
+	}
+
+	weights := make([]float64, len(results))
+	resultLimits := make([]*big.Int, len(results))
+	for i, result := range results {
+		weights[i] = result.Weight
+		resultLimits[i] = limits[common.HexToAddress(result.Strategy)]
+	}
+
+	targets, err := weightsToAmounts(state.TotalAssets, weights, resultLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &RebalanceRequest{
+		StrategyIDs:   make([]common.Address, len(results)),
+		TargetAmounts: targets,
+		Turnover:      plan.Turnover,
+	}
+	for i, result := range results {
+		req.StrategyIDs[i] = common.HexToAddress(result.Strategy)
+	}
+
+	return req, nil
 }
 
-// shouldRebalance determines if rebalancing is necessary
-func (r *Rebalancer) shouldRebalance(current *PortfolioState, target *RebalanceRequest) bool {
-	// TODO: Implement threshold logic
-	// Check if the difference between current and target allocations
-	// exceeds the rebalancing threshold (e.g., 5%)
-	
+// shouldRebalance evaluates the configured policies against the proposed rebalance
+func (r *Rebalancer) shouldRebalance(ctx context.Context, current *PortfolioState, target *RebalanceRequest, predictions []MLPrediction) *RebalanceDecision {
 	r.logger.Info("Checking if rebalancing is needed...")
-	
-	// Placeholder: always rebalance for now
-	return true
+
+	decision := evaluatePolicies(ctx, r.policies, &PolicyInput{
+		Current:     current,
+		Target:      target,
+		Predictions: predictions,
+	})
+
+	r.mu.Lock()
+	r.lastDecision = decision
+	r.mu.Unlock()
+
+	r.logger.WithFields(logrus.Fields{
+		"rebalance": decision.Rebalance,
+		"reason":    decision.Reason,
+		"checks":    decision.Checks,
+	}).Info("Rebalance decision evaluated")
+
+	return decision
 }
 
 // executeRebalanceTransaction sends the rebalance transaction to Base
-func (r *Rebalancer) executeRebalanceTransaction(ctx context.Context, req *RebalanceRequest) error {
-	// Get auth with updated gas settings
-	auth, err := r.contractManager.GetAuth(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get auth: %w", err)
-	}
-
-	// TODO: Use generated bindings to call rebalance
-	// Example:
-	// controller, err := bindings.NewAegisController(r.contractManager.GetControllerAddress(), r.contractManager.GetClient())
-	// if err != nil {
-	//     return err
-	// }
-	// tx, err := controller.Rebalance(auth, req.StrategyIDs, req.TargetAmounts, req.BridgeCallData)
-	// if err != nil {
-	//     return fmt.Errorf("rebalance transaction failed: %w", err)
-	// }
-	
+func (r *Rebalancer) executeRebalanceTransaction(ctx context.Context, req *RebalanceRequest, record *history.Record) error {
 	r.logger.WithFields(logrus.Fields{
-		"from":       auth.From.Hex(),
 		"controller": r.contractManager.GetControllerAddress().Hex(),
 		"strategies": len(req.StrategyIDs),
 	}).Info("Executing rebalance transaction...")
 
+	tx, err := r.contractManager.Rebalance(ctx, req.Targets())
+	if err != nil {
+		return fmt.Errorf("rebalance transaction failed: %w", err)
+	}
+	record.TxHash = tx.Hash().Hex()
+
 	// Wait for confirmation
-	// err = r.contractManager.WaitForTransaction(ctx, tx.Hash())
-	// if err != nil {
-	//     return fmt.Errorf("transaction confirmation failed: %w", err)
-	// }
+	result, err := r.contractManager.WaitForTransaction(ctx, tx.Hash())
+	if err != nil {
+		return fmt.Errorf("transaction confirmation failed: %w", err)
+	}
+	// A stuck transaction may have been replaced; record the one that was mined
+	record.TxHash = result.Hash.Hex()
+	if result.Receipt != nil {
+		record.BlockNumber = result.Receipt.BlockNumber.Uint64()
+		record.GasUsed = result.Receipt.GasUsed
+	}
 
-	r.logger.Info("Rebalance transaction confirmed!")
+	switch result.Status {
+	case web3client.TxStatusSuccess:
+	case web3client.TxStatusReverted:
+		// Replacements only change fees, so replaying the original gives the same reason
+		reason := r.contractManager.GetRevertReason(ctx, tx, result.Receipt.BlockNumber)
+		return fmt.Errorf("rebalance transaction %s reverted: %w", result.Hash.Hex(), reason)
+	default:
+		return fmt.Errorf("rebalance transaction %s was %s", result.Hash.Hex(), result.Status)
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"txHash":      result.Hash.Hex(),
+		"blockNumber": result.Receipt.BlockNumber,
+		"gasUsed":     result.Receipt.GasUsed,
+	}).Info("Rebalance transaction confirmed!")
 	return nil
 }
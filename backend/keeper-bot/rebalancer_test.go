@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aegis-yield/backend/data-aggregator"
+	"github.com/aegis-yield/backend/pkg/config"
+	"github.com/aegis-yield/backend/pkg/history"
+)
+
+// uptimeFeed answers latestRoundData for a Chainlink sequencer uptime feed, and
+// eth_feeHistory with a 0.05 gwei base fee and 0.001 gwei tips
+type uptimeFeed struct {
+	answer    int64 // 0 while the sequencer is up, 1 while it is down
+	startedAt time.Time
+}
+
+func (f *uptimeFeed) BlockNumber(ctx context.Context) (uint64, error) {
+	return 100, nil
+}
+
+func (f *uptimeFeed) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (f *uptimeFeed) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: number, Time: uint64(time.Now().Unix())}, nil
+}
+
+func (f *uptimeFeed) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return &ethereum.FeeHistory{
+		OldestBlock: new(big.Int).Sub(lastBlock, big.NewInt(1)),
+		BaseFee:     []*big.Int{big.NewInt(50_000_000), big.NewInt(50_000_000), big.NewInt(50_000_000)},
+		Reward:      [][]*big.Int{{big.NewInt(1_000_000)}, {big.NewInt(1_000_000)}},
+	}, nil
+}
+
+func (f *uptimeFeed) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	// roundId, answer, startedAt, updatedAt, answeredInRound
+	var out []byte
+	for _, word := range []int64{1, f.answer, f.startedAt.Unix(), f.startedAt.Unix(), 1} {
+		out = append(out, common.LeftPadBytes(big.NewInt(word).Bytes(), 32)...)
+	}
+	return out, nil
+}
+
+func quietLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func TestRebalanceSkipsWhileSequencerIsUnsafe(t *testing.T) {
+	tests := []struct {
+		name   string
+		feed   *uptimeFeed
+		reason aggregator.UnsafeReason
+	}{
+		{"down", &uptimeFeed{answer: 1, startedAt: time.Now().Add(-3 * time.Hour)}, aggregator.ReasonSequencerDown},
+		{"grace period", &uptimeFeed{answer: 0, startedAt: time.Now().Add(-10 * time.Minute)}, aggregator.ReasonSequencerGracePeriod},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Only the uptime feed is configured, without any price feed
+			cfg := &config.Config{
+				SequencerUptimeFeed:     "0xBCF85224fc0756B9Fa45aA7892530B47e10b6433",
+				SequencerGracePeriod:    time.Hour,
+				AggregatorSourceTimeout: time.Second,
+			}
+			market, err := buildDataAggregator(cfg, tt.feed)
+			if err != nil {
+				t.Fatalf("buildDataAggregator: %v", err)
+			}
+			if !market.Provides(aggregator.FieldSequencerUptime) {
+				t.Fatal("sequencer uptime source not registered without a price feed")
+			}
+
+			// The run must stop before reading the chain, so no contract manager is needed
+			rebalancer := NewRebalancer(nil, nil, nil, nil, market, 7, nil, quietLogger())
+
+			_, unsafe, err := rebalancer.checkMarketData(context.Background())
+			if err != nil {
+				t.Fatalf("checkMarketData: %v", err)
+			}
+			if unsafe == nil || unsafe.Reason != tt.reason {
+				t.Fatalf("unsafe = %v, want reason %s", unsafe, tt.reason)
+			}
+
+			record := &history.Record{}
+			if err := rebalancer.rebalance(context.Background(), record); err != nil {
+				t.Fatalf("rebalance: %v", err)
+			}
+			if record.Status != history.StatusSkipped || !strings.Contains(record.Reason, string(tt.reason)) {
+				t.Errorf("record = %s %q, want skipped with reason %s", record.Status, record.Reason, tt.reason)
+			}
+		})
+	}
+}
+
+func TestRebalanceProceedsOnceSequencerIsUpPastGracePeriod(t *testing.T) {
+	cfg := &config.Config{
+		SequencerUptimeFeed:     "0xBCF85224fc0756B9Fa45aA7892530B47e10b6433",
+		SequencerGracePeriod:    time.Hour,
+		AggregatorSourceTimeout: time.Second,
+	}
+	market, err := buildDataAggregator(cfg, &uptimeFeed{startedAt: time.Now().Add(-2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("buildDataAggregator: %v", err)
+	}
+
+	rebalancer := NewRebalancer(nil, nil, nil, nil, market, 7, nil, quietLogger())
+	data, unsafe, err := rebalancer.checkMarketData(context.Background())
+	if err != nil || unsafe != nil {
+		t.Fatalf("checkMarketData = %v, %v; want no unsafe data", unsafe, err)
+	}
+	// The run records the market it saw
+	if !data.SequencerUptime.Valid() || data.BaseFee.Value != 0.05 || data.PriorityFee.Value != 0.001 {
+		t.Errorf("market data = %+v, want the uptime and fee history", data)
+	}
+}
+
+func TestBuildDataAggregatorWithoutFeeds(t *testing.T) {
+	market, err := buildDataAggregator(&config.Config{}, &uptimeFeed{})
+	if err != nil {
+		t.Fatalf("buildDataAggregator: %v", err)
+	}
+	if !market.Provides(aggregator.FieldBaseFee) || market.Provides(aggregator.FieldETHPrice) || market.Provides(aggregator.FieldSequencerUptime) {
+		t.Error("want only the fee history source without any feed configured")
+	}
+}
@@ -0,0 +1,326 @@
+package mlclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Client is an HTTP client for the ML engine prediction service
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	options    Options
+	logger     *logrus.Logger
+}
+
+// Options configures request timeouts and retries
+type Options struct {
+	// Timeout bounds each individual HTTP attempt
+	Timeout time.Duration
+	// MaxRetries is the number of additional attempts for retryable failures
+	MaxRetries int
+	// RetryBackoff is the initial delay between attempts, doubled after each retry
+	RetryBackoff time.Duration
+}
+
+// DefaultOptions returns the options used when none are configured
+func DefaultOptions() Options {
+	return Options{
+		Timeout:      10 * time.Second,
+		MaxRetries:   3,
+		RetryBackoff: 500 * time.Millisecond,
+	}
+}
+
+// PredictRequest is the body of POST /predict
+type PredictRequest struct {
+	Strategies  []string               `json:"strategies"`
+	HorizonDays int                    `json:"horizon_days"`
+	Features    map[string]interface{} `json:"features,omitempty"`
+}
+
+// Prediction is the forecast for a single strategy
+type Prediction struct {
+	APY         float64 `json:"apy"`        // Predicted APY as a fraction (0.05 = 5%)
+	Volatility  float64 `json:"volatility"` // Predicted volatility as a fraction
+	Confidence  float64 `json:"confidence"` // Model confidence (0-1)
+	HorizonDays int     `json:"horizon_days"`
+
+	// Optional prediction interval of the APY, as fractions
+	APYLower *float64 `json:"apy_lower,omitempty"`
+	APYUpper *float64 `json:"apy_upper,omitempty"`
+}
+
+// PredictResponse is the body returned by POST /predict
+type PredictResponse struct {
+	Predictions map[string]Prediction `json:"predictions"`
+	Timestamp   string                `json:"timestamp"`
+}
+
+// HealthResponse is the body returned by GET /health
+type HealthResponse struct {
+	Status      string `json:"status"`
+	ModelLoaded bool   `json:"model_loaded"`
+	Device      string `json:"device"`
+}
+
+// ModelInfo is the body returned by GET /model/info
+type ModelInfo struct {
+	ModelType  string `json:"model_type"`
+	InputSize  int    `json:"input_size"`
+	HiddenSize int    `json:"hidden_size"`
+	NumLayers  int    `json:"num_layers"`
+	OutputSize int    `json:"output_size"`
+	Device     string `json:"device"`
+	Parameters int64  `json:"parameters"`
+}
+
+// RetrainResponse is the body returned by POST /retrain
+type RetrainResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// APIError is returned when the ML service responds with a non-2xx status
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("ML API returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// MissingPredictionsError is returned when the response does not cover every requested strategy
+type MissingPredictionsError struct {
+	Strategies []string
+}
+
+func (e *MissingPredictionsError) Error() string {
+	return fmt.Sprintf("ML API returned no prediction for strategies: %s", strings.Join(e.Strategies, ", "))
+}
+
+// NewClient creates a new ML engine client
+func NewClient(baseURL string, options Options, logger *logrus.Logger) (*Client, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid ML API URL %q", baseURL)
+	}
+
+	defaults := DefaultOptions()
+	if options.Timeout <= 0 {
+		options.Timeout = defaults.Timeout
+	}
+	if options.MaxRetries < 0 {
+		options.MaxRetries = 0
+	}
+	if options.RetryBackoff <= 0 {
+		options.RetryBackoff = defaults.RetryBackoff
+	}
+
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{},
+		options:    options,
+		logger:     logger,
+	}, nil
+}
+
+// Predict requests APY and volatility forecasts for the given strategies
+func (c *Client) Predict(ctx context.Context, req *PredictRequest) (*PredictResponse, error) {
+	if len(req.Strategies) == 0 {
+		return nil, errors.New("no strategies to predict")
+	}
+
+	var resp PredictResponse
+	if err := c.do(ctx, http.MethodPost, "/predict", req, &resp, true); err != nil {
+		return nil, err
+	}
+
+	if err := validatePredictions(req.Strategies, resp.Predictions); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Health returns the ML service health status
+func (c *Client) Health(ctx context.Context) (*HealthResponse, error) {
+	var resp HealthResponse
+	if err := c.do(ctx, http.MethodGet, "/health", nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ModelInfo returns metadata about the loaded model
+func (c *Client) ModelInfo(ctx context.Context) (*ModelInfo, error) {
+	var resp ModelInfo
+	if err := c.do(ctx, http.MethodGet, "/model/info", nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Retrain queues a model retraining job. It is not retried since it is not idempotent.
+func (c *Client) Retrain(ctx context.Context) (*RetrainResponse, error) {
+	var resp RetrainResponse
+	if err := c.do(ctx, http.MethodPost, "/retrain", struct{}{}, &resp, false); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// do performs a request, retrying network errors, 429 and 5xx responses with exponential backoff
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}, retry bool) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	attempts := 1
+	if retry {
+		attempts += c.options.MaxRetries
+	}
+	backoff := c.options.RetryBackoff
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		retryable, err := c.attempt(ctx, method, path, payload, out)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if !retryable || attempt == attempts {
+			break
+		}
+
+		c.logger.WithFields(logrus.Fields{
+			"path":    path,
+			"attempt": attempt,
+			"backoff": backoff,
+		}).WithError(err).Warn("ML API request failed, retrying")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	return fmt.Errorf("%s %s failed: %w", method, path, lastErr)
+}
+
+// attempt performs a single request and reports whether a failure is retryable
+func (c *Client) attempt(ctx context.Context, method, path string, payload []byte, out interface{}) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.options.Timeout)
+	defer cancel()
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return false, fmt.Errorf("failed to build request: %w", err)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// The caller's context being done is final; per-attempt timeouts are retryable
+		return ctx.Err() == nil || errors.Is(ctx.Err(), context.DeadlineExceeded), err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return true, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode, Message: errorMessage(data)}
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return retryable, apiErr
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return false, nil
+}
+
+// errorMessage extracts the {"error": "..."} message the Flask service returns
+func errorMessage(data []byte) string {
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(data, &body); err == nil && body.Error != "" {
+		return body.Error
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// validatePredictions checks that every requested strategy has a well-formed prediction
+func validatePredictions(strategies []string, predictions map[string]Prediction) error {
+	var missing []string
+	for _, strategy := range strategies {
+		prediction, ok := predictions[strategy]
+		if !ok {
+			missing = append(missing, strategy)
+			continue
+		}
+		if err := prediction.validate(); err != nil {
+			return fmt.Errorf("invalid prediction for strategy %s: %w", strategy, err)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return &MissingPredictionsError{Strategies: missing}
+	}
+
+	return nil
+}
+
+func (p Prediction) validate() error {
+	if math.IsNaN(p.APY) || math.IsInf(p.APY, 0) {
+		return fmt.Errorf("apy is not finite: %v", p.APY)
+	}
+	if math.IsNaN(p.Volatility) || p.Volatility < 0 || math.IsInf(p.Volatility, 0) {
+		return fmt.Errorf("volatility must be a non-negative number: %v", p.Volatility)
+	}
+	if math.IsNaN(p.Confidence) || p.Confidence < 0 || p.Confidence > 1 {
+		return fmt.Errorf("confidence must be between 0 and 1: %v", p.Confidence)
+	}
+	if p.APYLower != nil && (math.IsNaN(*p.APYLower) || math.IsInf(*p.APYLower, 0) || *p.APYLower > p.APY) {
+		return fmt.Errorf("apy_lower must be a finite number no greater than apy: %v", *p.APYLower)
+	}
+	if p.APYUpper != nil && (math.IsNaN(*p.APYUpper) || math.IsInf(*p.APYUpper, 0) || *p.APYUpper < p.APY) {
+		return fmt.Errorf("apy_upper must be a finite number no less than apy: %v", *p.APYUpper)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package mlclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const validPredictions = `{
+	"predictions": {
+		"aave": {"apy": 0.05, "volatility": 0.02, "confidence": 0.9, "horizon_days": 7},
+		"compound": {"apy": 0.04, "volatility": 0.01, "confidence": 0.8, "horizon_days": 7}
+	},
+	"timestamp": "2024-01-01T00:00:00Z"
+}`
+
+// testServer serves the responses in order, repeating the last one, and counts requests
+func testServer(t *testing.T, responses ...func(w http.ResponseWriter, r *http.Request)) (*Client, *int32) {
+	t.Helper()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(&calls, 1))
+		if call > len(responses) {
+			call = len(responses)
+		}
+		responses[call-1](w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	client, err := NewClient(server.URL, Options{
+		Timeout:      100 * time.Millisecond,
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	}, logger)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return client, &calls
+}
+
+func respond(status int, body string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		io.WriteString(w, body)
+	}
+}
+
+func predictRequest() *PredictRequest {
+	return &PredictRequest{Strategies: []string{"aave", "compound"}, HorizonDays: 7}
+}
+
+func TestPredictRetriesServerErrors(t *testing.T) {
+	client, calls := testServer(t,
+		respond(http.StatusServiceUnavailable, `{"error": "model loading"}`),
+		respond(http.StatusOK, validPredictions),
+	)
+
+	resp, err := client.Predict(context.Background(), predictRequest())
+	if err != nil {
+		t.Fatalf("Predict: %v", err)
+	}
+	if got := resp.Predictions["aave"].APY; got != 0.05 {
+		t.Errorf("aave APY = %v, want 0.05", got)
+	}
+	if got := atomic.LoadInt32(calls); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestPredictGivesUpAfterMaxRetries(t *testing.T) {
+	client, calls := testServer(t, respond(http.StatusInternalServerError, `{"error": "boom"}`))
+
+	_, err := client.Predict(context.Background(), predictRequest())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusInternalServerError || apiErr.Message != "boom" {
+		t.Errorf("APIError = %+v, want status 500 and message boom", apiErr)
+	}
+	if got := atomic.LoadInt32(calls); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+}
+
+func TestPredictDoesNotRetryClientErrors(t *testing.T) {
+	client, calls := testServer(t, respond(http.StatusBadRequest, `{"error": "unknown strategy"}`))
+
+	_, err := client.Predict(context.Background(), predictRequest())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("error = %v, want a 400 *APIError", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestPredictRetriesTimeouts(t *testing.T) {
+	client, calls := testServer(t,
+		func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		},
+		respond(http.StatusOK, validPredictions),
+	)
+
+	if _, err := client.Predict(context.Background(), predictRequest()); err != nil {
+		t.Fatalf("Predict: %v", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestPredictStopsWhenContextIsDone(t *testing.T) {
+	client, calls := testServer(t, respond(http.StatusServiceUnavailable, `{"error": "busy"}`))
+	client.options.RetryBackoff = time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.Predict(ctx, predictRequest())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want context.DeadlineExceeded", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestPredictMalformedJSON(t *testing.T) {
+	client, calls := testServer(t, respond(http.StatusOK, `{"predictions": {"aave": `))
+
+	_, err := client.Predict(context.Background(), predictRequest())
+	if err == nil || !strings.Contains(err.Error(), "failed to decode response") {
+		t.Fatalf("error = %v, want a decode error", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestPredictMissingStrategy(t *testing.T) {
+	client, _ := testServer(t, respond(http.StatusOK, `{
+		"predictions": {"aave": {"apy": 0.05, "volatility": 0.02, "confidence": 0.9, "horizon_days": 7}}
+	}`))
+
+	_, err := client.Predict(context.Background(), predictRequest())
+	var missing *MissingPredictionsError
+	if !errors.As(err, &missing) {
+		t.Fatalf("error = %v, want *MissingPredictionsError", err)
+	}
+	if len(missing.Strategies) != 1 || missing.Strategies[0] != "compound" {
+		t.Errorf("missing strategies = %v, want [compound]", missing.Strategies)
+	}
+}
+
+func TestPredictValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		prediction string
+		want       string
+	}{
+		{"negative volatility", `{"apy": 0.05, "volatility": -0.1, "confidence": 0.9}`, "volatility"},
+		{"confidence above 1", `{"apy": 0.05, "volatility": 0.02, "confidence": 1.5}`, "confidence"},
+		{"lower bound above apy", `{"apy": 0.05, "volatility": 0.02, "confidence": 0.9, "apy_lower": 0.06}`, "apy_lower"},
+		{"upper bound below apy", `{"apy": 0.05, "volatility": 0.02, "confidence": 0.9, "apy_upper": 0.04}`, "apy_upper"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := testServer(t, respond(http.StatusOK, `{"predictions": {"aave": `+tt.prediction+`}}`))
+
+			_, err := client.Predict(context.Background(), &PredictRequest{Strategies: []string{"aave"}})
+			if err == nil || !strings.Contains(err.Error(), "invalid prediction for strategy aave") || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want an invalid %s error", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPredictRequiresStrategies(t *testing.T) {
+	client, calls := testServer(t, respond(http.StatusOK, validPredictions))
+
+	if _, err := client.Predict(context.Background(), &PredictRequest{}); err == nil {
+		t.Fatal("Predict with no strategies succeeded")
+	}
+	if got := atomic.LoadInt32(calls); got != 0 {
+		t.Errorf("requests = %d, want 0", got)
+	}
+}
+
+func TestRetrainIsNotRetried(t *testing.T) {
+	client, calls := testServer(t, respond(http.StatusServiceUnavailable, `{"error": "busy"}`))
+
+	if _, err := client.Retrain(context.Background()); err == nil {
+		t.Fatal("Retrain succeeded against a failing server")
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestNewClientRejectsInvalidURL(t *testing.T) {
+	for _, baseURL := range []string{"", "localhost:5000", "http://"} {
+		if _, err := NewClient(baseURL, DefaultOptions(), logrus.New()); err == nil {
+			t.Errorf("NewClient(%q) succeeded", baseURL)
+		}
+	}
+}
@@ -0,0 +1,374 @@
+package solver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// ConstraintSet declares the limits every allocation must satisfy. Allocations and
+// shares are fractions of total assets; strategies are identified by
+// StrategyInput.Name, compared case-insensitively so addresses match in any case.
+type ConstraintSet struct {
+	MinAllocation float64 `json:"min_allocation" yaml:"min_allocation"` // Minimum weight of every strategy
+	MaxAllocation float64 `json:"max_allocation" yaml:"max_allocation"` // Maximum weight of every strategy
+	// RiskTolerance shapes the score objective: 0 ranks strategies by return over
+	// squared risk, 0.5 by return over risk and 1 by return alone
+	RiskTolerance  float64 `json:"risk_tolerance" yaml:"risk_tolerance"`
+	MaxVolatility  float64 `json:"max_volatility" yaml:"max_volatility"`     // Annual portfolio volatility cap, 0 disables
+	MinLiquidShare float64 `json:"min_liquid_share" yaml:"min_liquid_share"` // Minimum share in strategies marked liquid
+
+	Strategies map[string]StrategyConstraint `json:"strategies" yaml:"strategies"`
+	Groups     []GroupConstraint             `json:"groups" yaml:"groups"`
+}
+
+// StrategyConstraint overrides the global limits for one strategy. The effective
+// maximum is also capped by the strategy's on-chain MaxAllocation.
+type StrategyConstraint struct {
+	MinAllocation *float64 `json:"min_allocation,omitempty" yaml:"min_allocation,omitempty"`
+	MaxAllocation *float64 `json:"max_allocation,omitempty" yaml:"max_allocation,omitempty"`
+	Liquid        bool     `json:"liquid" yaml:"liquid"` // Counts toward MinLiquidShare
+}
+
+// GroupConstraint limits the combined weight of a set of strategies, e.g. all
+// L1-bridged strategies at most 30%
+type GroupConstraint struct {
+	Name          string   `json:"name" yaml:"name"`
+	Strategies    []string `json:"strategies" yaml:"strategies"`
+	MinAllocation float64  `json:"min_allocation" yaml:"min_allocation"`
+	MaxAllocation *float64 `json:"max_allocation,omitempty" yaml:"max_allocation,omitempty"`
+}
+
+// DefaultConstraints returns the solver's built-in limits: every strategy between 5%
+// and 50%
+func DefaultConstraints(riskTolerance float64) *ConstraintSet {
+	return &ConstraintSet{
+		MinAllocation: 0.05,
+		MaxAllocation: 0.50,
+		RiskTolerance: riskTolerance,
+	}
+}
+
+// LoadFile reads a YAML or JSON constraint file over the current values, so settings
+// the file leaves out keep their value. Unknown keys are rejected.
+func (c *ConstraintSet) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read constraints file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalWithOptions(data, c, yaml.Strict())
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(c)
+	default:
+		return fmt.Errorf("unsupported constraints file type %q (expected .yaml, .yml or .json)", path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse constraints file %s: %w", path, err)
+	}
+
+	return c.Validate(nil)
+}
+
+// InfeasibleError reports constraints that no allocation summing to 1 can satisfy, such
+// as three strategies capped at 20% each
+type InfeasibleError struct {
+	Constraint string // The constraint that cannot be met, e.g. "bounds" or a group name
+	Detail     string
+}
+
+func (e *InfeasibleError) Error() string {
+	return fmt.Sprintf("infeasible allocation constraints (%s): %s", e.Constraint, e.Detail)
+}
+
+// ConstraintError aggregates every problem found in a constraint set. Conflicts that
+// make the set infeasible are *InfeasibleError.
+type ConstraintError struct {
+	Problems []error
+}
+
+func (e *ConstraintError) Error() string {
+	lines := make([]string, len(e.Problems))
+	for i, err := range e.Problems {
+		lines[i] = "  - " + err.Error()
+	}
+	return fmt.Sprintf("invalid constraints (%d problems):\n%s", len(e.Problems), strings.Join(lines, "\n"))
+}
+
+// Unwrap returns the individual problems
+func (e *ConstraintError) Unwrap() []error {
+	return e.Problems
+}
+
+// Validate reports values out of range and, when strategies are given, constraints
+// that conflict with each other. Strategies the constraints name that are not among
+// the given strategies are ignored; see UnknownStrategies. A volatility cap below the
+// least volatile allocation is only detected when solving.
+func (c *ConstraintSet) Validate(strategies []StrategyInput) error {
+	var problems []error
+	fail := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Errorf(format, args...))
+	}
+	conflict := func(constraint, format string, args ...interface{}) {
+		problems = append(problems, &InfeasibleError{Constraint: constraint, Detail: fmt.Sprintf(format, args...)})
+	}
+	fraction := func(name string, value float64) {
+		if math.IsNaN(value) || value < 0 || value > 1 {
+			fail("%s must be between 0 and 1, got %v", name, value)
+		}
+	}
+
+	fraction("min_allocation", c.MinAllocation)
+	fraction("max_allocation", c.MaxAllocation)
+	fraction("risk_tolerance", c.RiskTolerance)
+	fraction("max_volatility", c.MaxVolatility)
+	fraction("min_liquid_share", c.MinLiquidShare)
+	if c.MinAllocation > c.MaxAllocation {
+		fail("min_allocation %v exceeds max_allocation %v", c.MinAllocation, c.MaxAllocation)
+	}
+
+	for name, limits := range c.Strategies {
+		if limits.MinAllocation != nil {
+			fraction("strategies."+name+".min_allocation", *limits.MinAllocation)
+		}
+		if limits.MaxAllocation != nil {
+			fraction("strategies."+name+".max_allocation", *limits.MaxAllocation)
+		}
+		if limits.MinAllocation != nil && limits.MaxAllocation != nil && *limits.MinAllocation > *limits.MaxAllocation {
+			fail("strategies.%s: min_allocation %v exceeds max_allocation %v", name, *limits.MinAllocation, *limits.MaxAllocation)
+		}
+	}
+
+	seen := make(map[string]bool, len(c.Groups))
+	for i, group := range c.Groups {
+		if group.Name == "" {
+			fail("groups[%d]: name is required", i)
+		} else if seen[group.Name] {
+			fail("groups[%d]: duplicate group name %q", i, group.Name)
+		}
+		seen[group.Name] = true
+		if len(group.Strategies) == 0 {
+			fail("group %s: strategies must not be empty", group.Name)
+		}
+		fraction("group "+group.Name+" min_allocation", group.MinAllocation)
+		if group.MaxAllocation != nil {
+			fraction("group "+group.Name+" max_allocation", *group.MaxAllocation)
+			if group.MinAllocation > *group.MaxAllocation {
+				fail("group %s: min_allocation %v exceeds max_allocation %v", group.Name, group.MinAllocation, *group.MaxAllocation)
+			}
+		}
+	}
+
+	if len(problems) > 0 || strategies == nil {
+		return constraintError(problems)
+	}
+
+	// Conflicts between the constraints and the strategies being allocated
+	index := strategyIndex(strategies)
+	lo, hi := c.bounds(strategies)
+	var sumLo, sumHi float64
+	for i := range lo {
+		if lo[i] > hi[i] {
+			conflict("bounds", "strategy %s: minimum weight %v exceeds maximum %v", strategies[i].Name, lo[i], hi[i])
+		}
+		sumLo += lo[i]
+		sumHi += hi[i]
+	}
+	if sumLo > 1+weightTolerance {
+		conflict("bounds", "minimum weights sum to %v, more than 1", sumLo)
+	}
+	if sumHi < 1-weightTolerance {
+		conflict("bounds", "maximum weights sum to %v, less than 1", sumHi)
+	}
+
+	for _, group := range c.Groups {
+		members := c.members(group, index)
+
+		var inLo, inHi, outLo, outHi float64
+		for i := range strategies {
+			if members[i] {
+				inLo, inHi = inLo+lo[i], inHi+hi[i]
+			} else {
+				outLo, outHi = outLo+lo[i], outHi+hi[i]
+			}
+		}
+		if group.MaxAllocation != nil {
+			if inLo > *group.MaxAllocation+weightTolerance {
+				conflict(group.Name, "member minimums sum to %v, more than the group maximum %v", inLo, *group.MaxAllocation)
+			}
+			if *group.MaxAllocation+outHi < 1-weightTolerance {
+				conflict(group.Name, "group maximum %v and the other strategies' maximums (%v) sum to less than 1", *group.MaxAllocation, outHi)
+			}
+		}
+		if inHi < group.MinAllocation-weightTolerance {
+			conflict(group.Name, "member maximums sum to %v, less than the group minimum %v", inHi, group.MinAllocation)
+		}
+		if group.MinAllocation+outLo > 1+weightTolerance {
+			conflict(group.Name, "group minimum %v and the other strategies' minimums (%v) sum to more than 1", group.MinAllocation, outLo)
+		}
+	}
+
+	if c.MinLiquidShare > 0 {
+		liquidHi := 0.0
+		for i, strategy := range strategies {
+			if c.limits(strategy).Liquid {
+				liquidHi += hi[i]
+			}
+		}
+		if liquidHi < c.MinLiquidShare-weightTolerance {
+			conflict("min_liquid_share", "liquid strategies can hold at most %v, less than %v", liquidHi, c.MinLiquidShare)
+		}
+	}
+
+	return constraintError(problems)
+}
+
+func constraintError(problems []error) error {
+	if len(problems) == 0 {
+		return nil
+	}
+	return &ConstraintError{Problems: problems}
+}
+
+// limits returns the overrides for a strategy
+func (c *ConstraintSet) limits(strategy StrategyInput) StrategyConstraint {
+	for name, limits := range c.Strategies {
+		if strings.EqualFold(name, strategy.Name) {
+			return limits
+		}
+	}
+	return StrategyConstraint{}
+}
+
+// bounds returns each strategy's weight bounds. A cap below the global minimum
+// allocation, such as a low on-chain MaxAllocation, lowers that strategy's minimum to
+// the cap. A strategy's own configured minimum is kept even above its cap, so
+// Validate reports the conflict.
+func (c *ConstraintSet) bounds(strategies []StrategyInput) (lo, hi []float64) {
+	lo = make([]float64, len(strategies))
+	hi = make([]float64, len(strategies))
+	for i, strategy := range strategies {
+		limits := c.limits(strategy)
+		max := c.MaxAllocation
+		if limits.MaxAllocation != nil {
+			max = *limits.MaxAllocation
+		}
+
+		hi[i] = math.Max(0, math.Min(max, strategy.MaxAllocation))
+		lo[i] = math.Min(c.MinAllocation, hi[i])
+		if limits.MinAllocation != nil {
+			lo[i] = *limits.MinAllocation
+		}
+	}
+	return lo, hi
+}
+
+// members marks the strategies in a group, ignoring members that are not allocated
+func (c *ConstraintSet) members(group GroupConstraint, index map[string]int) []bool {
+	members := make([]bool, len(index))
+	for _, name := range group.Strategies {
+		if i, ok := index[strings.ToLower(name)]; ok {
+			members[i] = true
+		}
+	}
+	return members
+}
+
+// UnknownStrategies returns the strategies named by per-strategy overrides or groups
+// that are not among the given strategies, e.g. ones removed from the controller.
+// They do not constrain the allocation.
+func (c *ConstraintSet) UnknownStrategies(strategies []StrategyInput) []string {
+	index := strategyIndex(strategies)
+	seen := make(map[string]bool)
+	var unknown []string
+	add := func(name string) {
+		if _, ok := index[strings.ToLower(name)]; !ok && !seen[strings.ToLower(name)] {
+			seen[strings.ToLower(name)] = true
+			unknown = append(unknown, name)
+		}
+	}
+	for name := range c.Strategies {
+		add(name)
+	}
+	for _, group := range c.Groups {
+		for _, name := range group.Strategies {
+			add(name)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// feasibleSet validates the constraints against the strategies and builds the set of
+// allowed weights. The covariance is used for the volatility cap.
+func (c *ConstraintSet) feasibleSet(strategies []StrategyInput, covariance [][]float64) (feasibleSet, error) {
+	if err := c.Validate(strategies); err != nil {
+		return feasibleSet{}, err
+	}
+
+	n := len(strategies)
+	set := feasibleSet{}
+	set.lo, set.hi = c.bounds(strategies)
+
+	index := strategyIndex(strategies)
+	for _, group := range c.Groups {
+		members := c.members(group, index)
+		row := make([]float64, n)
+		for i := range members {
+			if members[i] {
+				row[i] = 1
+			}
+		}
+		if group.MaxAllocation != nil {
+			set.linear = append(set.linear, linearConstraint{name: group.Name, a: row, b: *group.MaxAllocation})
+		}
+		if group.MinAllocation > 0 {
+			set.linear = append(set.linear, linearConstraint{name: group.Name, a: negate(row), b: -group.MinAllocation})
+		}
+	}
+
+	if c.MinLiquidShare > 0 {
+		row := make([]float64, n)
+		for i, strategy := range strategies {
+			if c.limits(strategy).Liquid {
+				row[i] = -1
+			}
+		}
+		set.linear = append(set.linear, linearConstraint{name: "min_liquid_share", a: row, b: -c.MinLiquidShare})
+	}
+
+	if c.MaxVolatility > 0 {
+		set.covariance = covariance
+		set.maxVariance = c.MaxVolatility * c.MaxVolatility
+	}
+
+	return set, nil
+}
+
+// strategyIndex maps lower-cased strategy names to their position
+func strategyIndex(strategies []StrategyInput) map[string]int {
+	index := make(map[string]int, len(strategies))
+	for i, strategy := range strategies {
+		index[strings.ToLower(strategy.Name)] = i
+	}
+	return index
+}
+
+func negate(v []float64) []float64 {
+	out := make([]float64, len(v))
+	for i := range v {
+		out[i] = -v[i]
+	}
+	return out
+}
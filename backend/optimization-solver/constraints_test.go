@@ -0,0 +1,245 @@
+package solver
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func fraction(v float64) *float64 {
+	return &v
+}
+
+func TestConstraintSetLoadFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr bool
+	}{
+		{
+			name: "yaml",
+			file: "constraints.yaml",
+			content: `max_allocation: 0.4
+min_liquid_share: 0.2
+strategies:
+  aave:
+    max_allocation: 0.3
+    liquid: true
+groups:
+  - name: bridged
+    strategies: [stargate]
+    max_allocation: 0.25
+`,
+		},
+		{
+			name: "json",
+			file: "constraints.json",
+			content: `{"max_allocation": 0.4, "min_liquid_share": 0.2,
+				"strategies": {"aave": {"max_allocation": 0.3, "liquid": true}},
+				"groups": [{"name": "bridged", "strategies": ["stargate"], "max_allocation": 0.25}]}`,
+		},
+		{name: "yaml unknown key", file: "constraints.yml", content: "max_alloc: 0.4\n", wantErr: true},
+		{name: "json unknown key", file: "constraints.json", content: `{"max_alloc": 0.4}`, wantErr: true},
+		{
+			name:    "yaml unknown nested key",
+			file:    "constraints.yaml",
+			content: "strategies:\n  aave:\n    max: 0.3\n",
+			wantErr: true,
+		},
+		{
+			name:    "json unknown nested key",
+			file:    "constraints.json",
+			content: `{"groups": [{"name": "bridged", "strategies": ["stargate"], "cap": 0.25}]}`,
+			wantErr: true,
+		},
+		{name: "out of range", file: "constraints.yaml", content: "max_allocation: 1.5\n", wantErr: true},
+		{name: "unsupported type", file: "constraints.toml", content: "max_allocation = 0.4\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			constraints := DefaultConstraints(0.5)
+			err := constraints.LoadFile(path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("LoadFile accepted %s", tt.content)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFile: %v", err)
+			}
+
+			want := &ConstraintSet{
+				MinAllocation:  0.05, // Left out, so the default is kept
+				MaxAllocation:  0.4,
+				RiskTolerance:  0.5,
+				MinLiquidShare: 0.2,
+				Strategies:     map[string]StrategyConstraint{"aave": {MaxAllocation: fraction(0.3), Liquid: true}},
+				Groups:         []GroupConstraint{{Name: "bridged", Strategies: []string{"stargate"}, MaxAllocation: fraction(0.25)}},
+			}
+			if !reflect.DeepEqual(constraints, want) {
+				t.Errorf("loaded %+v, want %+v", constraints, want)
+			}
+		})
+	}
+}
+
+func TestConstraintSetValidateConflicts(t *testing.T) {
+	capped := func(name string, maxAllocation float64) StrategyInput {
+		s := strategy(name, 0.05, 0.1)
+		s.MaxAllocation = maxAllocation
+		return s
+	}
+	four := []StrategyInput{capped("a", 0.25), capped("b", 0.25), capped("c", 1), capped("d", 1)}
+
+	tests := []struct {
+		name        string
+		constraints ConstraintSet
+		strategies  []StrategyInput
+		infeasible  []string // Constraint of each *InfeasibleError
+		problems    int
+	}{
+		{
+			name: "feasible",
+			constraints: ConstraintSet{
+				MaxAllocation: 0.5,
+				Groups:        []GroupConstraint{{Name: "ab", Strategies: []string{"a", "b"}, MinAllocation: 0.3, MaxAllocation: fraction(0.5)}},
+			},
+			strategies: four,
+		},
+		{
+			name: "group minimum above member maximums",
+			constraints: ConstraintSet{
+				MaxAllocation: 1,
+				Groups:        []GroupConstraint{{Name: "ab", Strategies: []string{"a", "b"}, MinAllocation: 0.6}},
+			},
+			strategies: four,
+			infeasible: []string{"ab"},
+			problems:   1,
+		},
+		{
+			name: "group maximum leaves the rest unable to reach 1",
+			constraints: ConstraintSet{
+				MaxAllocation: 0.4,
+				Groups:        []GroupConstraint{{Name: "cd", Strategies: []string{"c", "d"}, MaxAllocation: fraction(0.3)}},
+			},
+			strategies: four,
+			infeasible: []string{"cd"},
+			problems:   1,
+		},
+		{
+			name: "group minimum above its maximum",
+			constraints: ConstraintSet{
+				MaxAllocation: 1,
+				Groups:        []GroupConstraint{{Name: "cd", Strategies: []string{"c", "d"}, MinAllocation: 0.5, MaxAllocation: fraction(0.4)}},
+			},
+			strategies: four,
+			problems:   1,
+		},
+		{
+			name: "liquid strategies cannot reach the minimum share",
+			constraints: ConstraintSet{
+				MaxAllocation:  1,
+				MinLiquidShare: 0.6,
+				Strategies:     map[string]StrategyConstraint{"a": {Liquid: true}, "b": {Liquid: true}},
+			},
+			strategies: four,
+			infeasible: []string{"min_liquid_share"},
+			problems:   1,
+		},
+		{
+			name: "strategy minimum above its on-chain cap",
+			constraints: ConstraintSet{
+				MaxAllocation: 1,
+				Strategies:    map[string]StrategyConstraint{"a": {MinAllocation: fraction(0.3)}},
+			},
+			strategies: four,
+			infeasible: []string{"bounds"},
+			problems:   1,
+		},
+		{
+			name: "strategy minimum above its configured maximum",
+			constraints: ConstraintSet{
+				MaxAllocation: 1,
+				Strategies:    map[string]StrategyConstraint{"c": {MinAllocation: fraction(0.3), MaxAllocation: fraction(0.2)}},
+			},
+			strategies: four,
+			problems:   1,
+		},
+		{
+			name: "names match in any case",
+			constraints: ConstraintSet{
+				MaxAllocation:  1,
+				MinLiquidShare: 0.3,
+				Strategies:     map[string]StrategyConstraint{"0xABCDEF": {MaxAllocation: fraction(0.1), Liquid: true}},
+				Groups:         []GroupConstraint{{Name: "x", Strategies: []string{"0XAbCdEf"}, MinAllocation: 0.2}},
+			},
+			strategies: []StrategyInput{capped("0xabcdef", 1), capped("b", 1)},
+			infeasible: []string{"x", "min_liquid_share"},
+			problems:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.constraints.Validate(tt.strategies)
+			if tt.problems == 0 {
+				if err != nil {
+					t.Fatalf("Validate: %v", err)
+				}
+				return
+			}
+
+			var constraintErr *ConstraintError
+			if !errors.As(err, &constraintErr) {
+				t.Fatalf("Validate error = %v, want *ConstraintError", err)
+			}
+			if len(constraintErr.Problems) != tt.problems {
+				t.Errorf("problems = %v, want %d", constraintErr.Problems, tt.problems)
+			}
+
+			var infeasible []string
+			for _, problem := range constraintErr.Problems {
+				var infeasibleErr *InfeasibleError
+				if errors.As(problem, &infeasibleErr) {
+					infeasible = append(infeasible, infeasibleErr.Constraint)
+				}
+			}
+			sort.Strings(infeasible)
+			want := append([]string(nil), tt.infeasible...)
+			sort.Strings(want)
+			if !reflect.DeepEqual(infeasible, want) {
+				t.Errorf("infeasible constraints = %v, want %v", infeasible, want)
+			}
+		})
+	}
+}
+
+func TestConstraintSetNamesCaseInsensitive(t *testing.T) {
+	constraints := &ConstraintSet{
+		MaxAllocation: 1,
+		Strategies:    map[string]StrategyConstraint{"0xABCDEF": {MaxAllocation: fraction(0.1)}},
+		Groups:        []GroupConstraint{{Name: "x", Strategies: []string{"0XAbCdEf", "gone"}}},
+	}
+	strategies := []StrategyInput{strategy("0xabcdef", 0.05, 0.1), strategy("b", 0.05, 0.1)}
+
+	if _, hi := constraints.bounds(strategies); hi[0] != 0.1 {
+		t.Errorf("maximum weight = %v, want the override 0.1", hi[0])
+	}
+	if members := constraints.members(constraints.Groups[0], strategyIndex(strategies)); !members[0] || members[1] {
+		t.Errorf("group members = %v, want only the first strategy", members)
+	}
+	if unknown := constraints.UnknownStrategies(strategies); !reflect.DeepEqual(unknown, []string{"gone"}) {
+		t.Errorf("unknown strategies = %v, want [gone]", unknown)
+	}
+}
@@ -0,0 +1,300 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Objective selects what the mean-variance optimizer solves for
+type Objective string
+
+const (
+	// ObjectiveMaxSharpe maximizes (expected return − risk-free rate) / volatility
+	ObjectiveMaxSharpe Objective = "max_sharpe"
+	// ObjectiveMinVariance minimizes variance subject to an expected return floor
+	ObjectiveMinVariance Objective = "min_variance"
+)
+
+// MeanVarianceConfig configures OptimizeMeanVariance
+type MeanVarianceConfig struct {
+	Objective    Objective
+	RiskFreeRate float64 // Subtracted from expected returns for the Sharpe ratio
+	// TargetReturn is the minimum expected return for ObjectiveMinVariance. Zero, or a
+	// target below the global minimum variance portfolio's return, gives that portfolio.
+	TargetReturn float64
+
+	// Covariance of strategy returns, in strategy order. When nil it is built from
+	// Correlation and each strategy's Volatility; when both are nil strategies are
+	// treated as uncorrelated.
+	Covariance  [][]float64
+	Correlation [][]float64
+}
+
+// goldenSectionIterations narrows the max-Sharpe search interval by 0.618^80
+const goldenSectionIterations = 80
+
+// OptimizeMeanVariance calculates a Markowitz mean-variance allocation. Weights are
+// long-only and satisfy the solver's constraint set and each strategy's MaxAllocation.
+// The result is deterministic for a given input.
+func (os *OptimizationSolver) OptimizeMeanVariance(
+	strategies []StrategyInput,
+	totalAssets float64,
+	config MeanVarianceConfig,
+) ([]AllocationResult, error) {
+	if len(strategies) == 0 {
+		return nil, errors.New("no strategies provided")
+	}
+
+	covariance, err := covarianceMatrix(strategies, config.Covariance, config.Correlation)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := os.constraints.feasibleSet(strategies, covariance)
+	if err != nil {
+		return nil, err
+	}
+
+	mu := make([]float64, len(strategies))
+	for i, strategy := range strategies {
+		if math.IsNaN(strategy.ExpectedReturn) || math.IsInf(strategy.ExpectedReturn, 0) {
+			return nil, fmt.Errorf("strategy %s: expected return must be a finite number", strategy.Name)
+		}
+		mu[i] = strategy.ExpectedReturn
+	}
+
+	var weights []float64
+	switch config.Objective {
+	case ObjectiveMinVariance:
+		weights, err = minVariance(covariance, mu, set, config.TargetReturn)
+	case ObjectiveMaxSharpe:
+		weights, err = maxSharpe(covariance, mu, set, config.RiskFreeRate)
+	default:
+		return nil, fmt.Errorf("unknown objective %q (expected max_sharpe or min_variance)", config.Objective)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return allocationResults(strategies, weights, totalAssets), nil
+}
+
+// minVariance solves for the lowest variance portfolio with an expected return of at
+// least target
+func minVariance(covariance [][]float64, mu []float64, set feasibleSet, target float64) ([]float64, error) {
+	maximum, err := maxReturn(mu, set)
+	if err != nil {
+		return nil, err
+	}
+	if target > maximum+weightTolerance {
+		return nil, &InfeasibleError{
+			Constraint: "target_return",
+			Detail:     fmt.Sprintf("target return %v exceeds the maximum achievable %v", target, maximum),
+		}
+	}
+
+	problem := &qpProblem{q: covariance, feasibleSet: set}
+	weights, err := problem.solve()
+	if err != nil || dot(mu, weights) >= target {
+		return weights, err
+	}
+
+	problem.feasibleSet = set.withLinear(linearConstraint{name: "target_return", a: negate(mu), b: -target})
+	return problem.solve()
+}
+
+// maxSharpe searches the efficient frontier for the tangency portfolio. Along the
+// frontier the Sharpe ratio is unimodal in the target return, so a golden-section
+// search between the minimum variance and maximum return portfolios finds it.
+func maxSharpe(covariance [][]float64, mu []float64, set feasibleSet, riskFreeRate float64) ([]float64, error) {
+	for i := range covariance {
+		if covariance[i][i] <= 0 {
+			return nil, fmt.Errorf("strategy %d has zero variance; the Sharpe ratio is unbounded", i)
+		}
+	}
+
+	highest, err := maxReturn(mu, set)
+	if err != nil {
+		return nil, err
+	}
+	if highest <= riskFreeRate {
+		return nil, fmt.Errorf("no portfolio returns more than the risk-free rate %v (best %v)", riskFreeRate, highest)
+	}
+
+	frontier := func(target float64) ([]float64, float64, error) {
+		weights, err := minVariance(covariance, mu, set, target)
+		if err != nil {
+			return nil, 0, err
+		}
+		return weights, sharpe(covariance, mu, weights, riskFreeRate), nil
+	}
+
+	best, bestSharpe, err := frontier(0)
+	if err != nil {
+		return nil, err
+	}
+	low := dot(mu, best)
+	high := highest
+
+	consider := func(target float64) (float64, error) {
+		weights, value, err := frontier(target)
+		if err != nil {
+			return 0, err
+		}
+		if value > bestSharpe {
+			best, bestSharpe = weights, value
+		}
+		return value, nil
+	}
+
+	if _, err := consider(high); err != nil {
+		return nil, err
+	}
+
+	ratio := (math.Sqrt(5) - 1) / 2
+	a, b := low, high
+	x1, x2 := b-ratio*(b-a), a+ratio*(b-a)
+	f1, err := consider(x1)
+	if err != nil {
+		return nil, err
+	}
+	f2, err := consider(x2)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < goldenSectionIterations && b-a > 1e-12; i++ {
+		if f1 < f2 {
+			a, x1, f1 = x1, x2, f2
+			x2 = a + ratio*(b-a)
+			if f2, err = consider(x2); err != nil {
+				return nil, err
+			}
+		} else {
+			b, x2, f2 = x2, x1, f1
+			x1 = b - ratio*(b-a)
+			if f1, err = consider(x1); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return best, nil
+}
+
+// maxReturn is the highest expected return any feasible portfolio can reach. On the
+// capped simplex that is found greedily: start every strategy at its minimum and fill
+// the highest returns up to their caps. Other constraints need the QP solver.
+func maxReturn(mu []float64, set feasibleSet) (float64, error) {
+	if !set.simple() {
+		problem := &qpProblem{c: mu, feasibleSet: set}
+		weights, err := problem.solve()
+		if err != nil {
+			return 0, err
+		}
+		return dot(mu, weights), nil
+	}
+
+	lo, hi := set.lo, set.hi
+	order := make([]int, len(mu))
+	remaining := 1.0
+	for i := range order {
+		order[i] = i
+		remaining -= lo[i]
+	}
+	for i := 1; i < len(order); i++ {
+		for j := i; j > 0 && mu[order[j]] > mu[order[j-1]]; j-- {
+			order[j], order[j-1] = order[j-1], order[j]
+		}
+	}
+
+	total := dot(mu, lo)
+	for _, i := range order {
+		add := math.Min(remaining, hi[i]-lo[i])
+		if add <= 0 {
+			continue
+		}
+		total += add * mu[i]
+		remaining -= add
+	}
+	return total, nil
+}
+
+func sharpe(covariance [][]float64, mu, weights []float64, riskFreeRate float64) float64 {
+	variance := dot(weights, matVec(covariance, weights))
+	if variance <= 0 {
+		return math.Inf(-1)
+	}
+	return (dot(mu, weights) - riskFreeRate) / math.Sqrt(variance)
+}
+
+// covarianceMatrix validates the given covariance, or builds one from the
+// correlations and strategy volatilities
+func covarianceMatrix(strategies []StrategyInput, covariance, correlation [][]float64) ([][]float64, error) {
+	n := len(strategies)
+
+	if covariance != nil {
+		if err := checkSymmetric("covariance", covariance, n); err != nil {
+			return nil, err
+		}
+		for i := range covariance {
+			if covariance[i][i] < 0 {
+				return nil, fmt.Errorf("covariance: variance of strategy %s is negative", strategies[i].Name)
+			}
+		}
+		return covariance, nil
+	}
+
+	if correlation == nil {
+		correlation = make([][]float64, n)
+		for i := range correlation {
+			correlation[i] = make([]float64, n)
+			correlation[i][i] = 1
+		}
+	}
+	if err := checkSymmetric("correlation", correlation, n); err != nil {
+		return nil, err
+	}
+
+	covariance = make([][]float64, n)
+	for i := range covariance {
+		if correlation[i][i] != 1 {
+			return nil, fmt.Errorf("correlation: diagonal entry %d must be 1, got %v", i, correlation[i][i])
+		}
+		volatility := strategies[i].Volatility
+		if math.IsNaN(volatility) || math.IsInf(volatility, 0) || volatility < 0 {
+			return nil, fmt.Errorf("strategy %s: volatility must be a non-negative number, got %v", strategies[i].Name, volatility)
+		}
+
+		covariance[i] = make([]float64, n)
+		for j := range covariance[i] {
+			if correlation[i][j] < -1 || correlation[i][j] > 1 {
+				return nil, fmt.Errorf("correlation: entry (%d, %d) must be between -1 and 1, got %v", i, j, correlation[i][j])
+			}
+			covariance[i][j] = correlation[i][j] * volatility * strategies[j].Volatility
+		}
+	}
+	return covariance, nil
+}
+
+func checkSymmetric(name string, m [][]float64, n int) error {
+	if len(m) != n {
+		return fmt.Errorf("%s: expected %dx%d matrix, got %d rows", name, n, n, len(m))
+	}
+	for i := range m {
+		if len(m[i]) != n {
+			return fmt.Errorf("%s: row %d has %d entries, expected %d", name, i, len(m[i]), n)
+		}
+	}
+	for i := range m {
+		for j := range m[i] {
+			if math.IsNaN(m[i][j]) || math.IsInf(m[i][j], 0) {
+				return fmt.Errorf("%s: entry (%d, %d) is not a finite number", name, i, j)
+			}
+			if math.Abs(m[i][j]-m[j][i]) > 1e-12*math.Max(1, math.Abs(m[i][j])) {
+				return fmt.Errorf("%s: matrix is not symmetric at (%d, %d)", name, i, j)
+			}
+		}
+	}
+	return nil
+}
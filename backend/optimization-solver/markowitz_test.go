@@ -0,0 +1,100 @@
+package solver
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// unconstrainedSolver allows any long-only weights, so results match the closed-form
+// Markowitz portfolios
+func unconstrainedSolver(t *testing.T) *OptimizationSolver {
+	t.Helper()
+	optimizer, err := NewConstrainedSolver(&ConstraintSet{MaxAllocation: 1, RiskTolerance: 0.5})
+	if err != nil {
+		t.Fatalf("NewConstrainedSolver: %v", err)
+	}
+	return optimizer
+}
+
+func strategy(name string, expectedReturn, volatility float64) StrategyInput {
+	return StrategyInput{Name: name, ExpectedReturn: expectedReturn, Volatility: volatility, MaxAllocation: 1}
+}
+
+func TestOptimizeMeanVariance(t *testing.T) {
+	correlated := []StrategyInput{
+		strategy("a", 0.08, 0.10),
+		strategy("b", 0.12, 0.15),
+		strategy("c", 0.15, 0.20),
+	}
+	correlation := [][]float64{
+		{1, 0.5, 0.2},
+		{0.5, 1, 0.3},
+		{0.2, 0.3, 1},
+	}
+
+	tests := []struct {
+		name       string
+		strategies []StrategyInput
+		config     MeanVarianceConfig
+		want       []float64
+	}{
+		{
+			name:       "uncorrelated max sharpe",
+			strategies: []StrategyInput{strategy("a", 0.20, 0.10), strategy("b", 0.10, 0.10)},
+			config:     MeanVarianceConfig{Objective: ObjectiveMaxSharpe},
+			want:       []float64{2.0 / 3, 1.0 / 3},
+		},
+		{
+			name:       "uncorrelated min variance",
+			strategies: []StrategyInput{strategy("a", 0.05, 0.10), strategy("b", 0.10, 0.20)},
+			config:     MeanVarianceConfig{Objective: ObjectiveMinVariance},
+			want:       []float64{0.8, 0.2},
+		},
+		{
+			// Σ⁻¹μ normalized, with Σ built from the correlations
+			name:       "correlated max sharpe",
+			strategies: correlated,
+			config:     MeanVarianceConfig{Objective: ObjectiveMaxSharpe, Correlation: correlation},
+			want:       []float64{0.485594740, 0.254393305, 0.260011955},
+		},
+		{
+			// Σ⁻¹1 normalized
+			name:       "correlated min variance",
+			strategies: correlated,
+			config:     MeanVarianceConfig{Objective: ObjectiveMinVariance, Correlation: correlation},
+			want:       []float64{0.784530387, 0.088397790, 0.127071823},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := unconstrainedSolver(t).OptimizeMeanVariance(tt.strategies, 1000, tt.config)
+			if err != nil {
+				t.Fatalf("OptimizeMeanVariance: %v", err)
+			}
+			for i, result := range results {
+				if math.Abs(result.Weight-tt.want[i]) > 1e-4 {
+					t.Errorf("weight of %s = %v, want %v", result.Strategy, result.Weight, tt.want[i])
+				}
+				if math.Abs(result.Allocation-1000*result.Weight) > 1e-9 {
+					t.Errorf("allocation of %s = %v, want %v", result.Strategy, result.Allocation, 1000*result.Weight)
+				}
+			}
+		})
+	}
+}
+
+func TestOptimizeMeanVarianceTargetAboveMaximum(t *testing.T) {
+	strategies := []StrategyInput{strategy("a", 0.05, 0.10), strategy("b", 0.10, 0.20)}
+	config := MeanVarianceConfig{Objective: ObjectiveMinVariance, TargetReturn: 0.11}
+
+	_, err := unconstrainedSolver(t).OptimizeMeanVariance(strategies, 1000, config)
+	var infeasible *InfeasibleError
+	if !errors.As(err, &infeasible) {
+		t.Fatalf("error = %v, want *InfeasibleError", err)
+	}
+	if infeasible.Constraint != "target_return" {
+		t.Errorf("constraint = %q, want target_return", infeasible.Constraint)
+	}
+}
@@ -0,0 +1,148 @@
+package solver
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// tradeCost is a proportional cost of moving each weight away from its anchor
+type tradeCost struct {
+	anchor []float64
+	entry  []float64 // Cost per unit of weight added
+	exit   []float64 // Cost per unit of weight removed
+}
+
+// value returns the cost of moving from the anchor to w
+func (c *tradeCost) value(w []float64) float64 {
+	total := 0.0
+	for i := range w {
+		if delta := w[i] - c.anchor[i]; delta > 0 {
+			total += c.entry[i] * delta
+		} else {
+			total -= c.exit[i] * delta
+		}
+	}
+	return total
+}
+
+// shrink is the proximal operator of step * cost for weight i: x moves toward the
+// anchor by the marginal cost and stops there
+func (c *tradeCost) shrink(i int, x, step float64) float64 {
+	switch up, down := step*c.entry[i], step*c.exit[i]; {
+	case x > c.anchor[i]+up:
+		return x - up
+	case x < c.anchor[i]-down:
+		return x + down
+	default:
+		return c.anchor[i]
+	}
+}
+
+// projectCappedSimplex returns the point closest to v (in Euclidean distance) whose
+// weights sum to 1 with lo[i] <= w[i] <= hi[i]
+func projectCappedSimplex(v, lo, hi []float64) ([]float64, error) {
+	return proxCappedSimplex(v, lo, hi, nil, 0)
+}
+
+// proxCappedSimplex returns the weights w on the capped simplex minimizing
+// ½‖w − v‖² + step·cost(w); a nil cost gives the Euclidean projection. The solution
+// is w[i] = clamp(shrink(v[i] − tau), lo[i], hi[i]) for the tau at which the weights
+// sum to 1. The sum is piecewise linear and non-increasing in tau, so tau is found
+// exactly between the two breakpoints that bracket 1.
+func proxCappedSimplex(v, lo, hi []float64, cost *tradeCost, step float64) ([]float64, error) {
+	if err := checkBounds(lo, hi); err != nil {
+		return nil, err
+	}
+
+	weight := func(i int, tau float64) float64 {
+		x := v[i] - tau
+		if cost != nil {
+			x = cost.shrink(i, x, step)
+		}
+		return clamp(x, lo[i], hi[i])
+	}
+
+	breakpoints := make([]float64, 0, 6*len(v))
+	for i := range v {
+		if cost == nil {
+			breakpoints = append(breakpoints, v[i]-hi[i], v[i]-lo[i])
+			continue
+		}
+		// Kinks of shrink, and of clamp seen through shrink
+		up, down := step*cost.entry[i], step*cost.exit[i]
+		for _, kink := range []float64{cost.anchor[i], lo[i], hi[i]} {
+			breakpoints = append(breakpoints, v[i]-kink-up, v[i]-kink+down)
+		}
+	}
+	sort.Float64s(breakpoints)
+
+	sum := func(tau float64) float64 {
+		total := 0.0
+		for i := range v {
+			total += weight(i, tau)
+		}
+		return total
+	}
+
+	// sum is non-increasing in tau: sum(hi) at the first breakpoint, sum(lo) at the last
+	tau := breakpoints[len(breakpoints)-1]
+	prev, prevSum := breakpoints[0], sum(breakpoints[0])
+	if prevSum <= 1 {
+		tau = prev
+	} else {
+		for _, next := range breakpoints[1:] {
+			nextSum := sum(next)
+			if nextSum <= 1 {
+				tau = next
+				if prevSum != nextSum {
+					tau = prev + (prevSum-1)*(next-prev)/(prevSum-nextSum)
+				}
+				break
+			}
+			prev, prevSum = next, nextSum
+		}
+	}
+
+	w := make([]float64, len(v))
+	for i := range v {
+		w[i] = weight(i, tau)
+	}
+	return w, nil
+}
+
+// checkBounds reports bounds that no set of weights summing to 1 can satisfy
+func checkBounds(lo, hi []float64) error {
+	if len(lo) == 0 {
+		return fmt.Errorf("no strategies provided")
+	}
+
+	var sumLo, sumHi float64
+	for i := range lo {
+		sumLo += lo[i]
+		sumHi += hi[i]
+	}
+	infeasible := func(format string, args ...interface{}) error {
+		return &InfeasibleError{Constraint: "bounds", Detail: fmt.Sprintf(format, args...)}
+	}
+
+	for i := range lo {
+		if lo[i] > hi[i] {
+			return infeasible("strategy %d: minimum weight %v exceeds maximum %v", i, lo[i], hi[i])
+		}
+	}
+	if sumLo > 1+weightTolerance {
+		return infeasible("minimum weights sum to %v, more than 1", sumLo)
+	}
+	if sumHi < 1-weightTolerance {
+		return infeasible("maximum weights sum to %v, less than 1", sumHi)
+	}
+	return nil
+}
+
+// weightTolerance absorbs floating point error in weight sums and bounds
+const weightTolerance = 1e-9
+
+func clamp(value, lo, hi float64) float64 {
+	return math.Min(math.Max(value, lo), hi)
+}
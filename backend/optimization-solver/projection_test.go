@@ -0,0 +1,118 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// randomBounds returns n feasible weight bounds: lo <= hi, Σlo <= 1 <= Σhi. Caps
+// that fall short of 1 are raised until they sum to exactly 1, the tightest case.
+func randomBounds(rng *rand.Rand, n int) (lo, hi []float64) {
+	lo, hi = make([]float64, n), make([]float64, n)
+	var sumHi, slack float64
+	for i := 0; i < n; i++ {
+		lo[i] = rng.Float64() / float64(n)
+		hi[i] = lo[i] + rng.Float64()*(1-lo[i])
+		sumHi += hi[i]
+		slack += 1 - hi[i]
+	}
+	if sumHi < 1 {
+		for i := range hi {
+			hi[i] += (1 - hi[i]) * (1 - sumHi) / slack
+		}
+	}
+	return lo, hi
+}
+
+func checkWeights(t *testing.T, label string, w, lo, hi []float64) {
+	t.Helper()
+	sum := 0.0
+	for i := range w {
+		if w[i] < lo[i]-weightTolerance || w[i] > hi[i]+weightTolerance {
+			t.Errorf("%s: weight %d = %v, outside [%v, %v]", label, i, w[i], lo[i], hi[i])
+		}
+		sum += w[i]
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("%s: weights sum to %v, want 1", label, sum)
+	}
+}
+
+func TestProjectCappedSimplexProperties(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 1000; trial++ {
+		n := 1 + rng.Intn(10)
+		lo, hi := randomBounds(rng, n)
+		v := make([]float64, n)
+		for i := range v {
+			v[i] = rng.NormFloat64()
+		}
+
+		w, err := projectCappedSimplex(v, lo, hi)
+		if err != nil {
+			t.Fatalf("trial %d: projectCappedSimplex(%v, %v, %v): %v", trial, v, lo, hi, err)
+		}
+		checkWeights(t, fmt.Sprintf("trial %d", trial), w, lo, hi)
+	}
+}
+
+func TestOptimizeRespectsBounds(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	optimizer := NewOptimizationSolver(0.5)
+	for trial := 0; trial < 500; trial++ {
+		// The default 50% cap needs at least two strategies
+		n := 2 + rng.Intn(8)
+		strategies := make([]StrategyInput, n)
+		for i := range strategies {
+			strategies[i] = StrategyInput{
+				Name:           fmt.Sprintf("s%d", i),
+				ExpectedReturn: rng.Float64() * 0.3,
+				Volatility:     0.01 + rng.Float64()*0.3,
+				RiskScore:      1 + rng.Float64()*99,
+				MaxAllocation:  1,
+			}
+		}
+		// Leave on-chain caps summing to at least 1
+		for i := range strategies {
+			if limit := 0.05 + rng.Float64()*0.95; limit*float64(n) >= 1 {
+				strategies[i].MaxAllocation = limit
+			}
+		}
+
+		lo, hi := optimizer.constraints.bounds(strategies)
+		results, err := optimizer.Optimize(strategies, 1000)
+		if err != nil {
+			t.Fatalf("trial %d: Optimize: %v", trial, err)
+		}
+		w := make([]float64, n)
+		for i, result := range results {
+			w[i] = result.Weight
+		}
+		checkWeights(t, fmt.Sprintf("trial %d", trial), w, lo, hi)
+	}
+}
+
+func TestOptimizeInfeasibleCaps(t *testing.T) {
+	strategies := make([]StrategyInput, 3)
+	for i := range strategies {
+		strategies[i] = StrategyInput{
+			Name:           fmt.Sprintf("s%d", i),
+			ExpectedReturn: 0.1,
+			Volatility:     0.1,
+			RiskScore:      50,
+			MaxAllocation:  0.2,
+		}
+	}
+
+	_, err := NewOptimizationSolver(0.5).Optimize(strategies, 1000)
+	var infeasible *InfeasibleError
+	if !errors.As(err, &infeasible) {
+		t.Fatalf("error = %v, want *InfeasibleError", err)
+	}
+	if infeasible.Constraint != "bounds" {
+		t.Errorf("constraint = %q, want bounds", infeasible.Constraint)
+	}
+}
@@ -0,0 +1,460 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// qpProblem is a convex quadratic program over portfolio weights:
+//
+//	minimize    ½ wᵀQw − cᵀw + cost(w)
+//	subject to  w in the feasible set
+//
+// Q must be symmetric positive semi-definite. The optional cost is a proportional
+// trading cost away from a current allocation.
+type qpProblem struct {
+	q    [][]float64
+	c    []float64
+	cost *tradeCost
+	feasibleSet
+}
+
+// feasibleSet is the set of allowed weights: Σw = 1, lo ≤ w ≤ hi, aᵀw ≤ b for each
+// linear constraint and, when covariance is set, wᵀΣw ≤ maxVariance
+type feasibleSet struct {
+	lo, hi      []float64
+	linear      []linearConstraint
+	covariance  [][]float64
+	maxVariance float64
+}
+
+// linearConstraint is aᵀw ≤ b
+type linearConstraint struct {
+	name string
+	a    []float64
+	b    float64
+}
+
+// simple reports whether the set is just the capped simplex
+func (s *feasibleSet) simple() bool {
+	return len(s.linear) == 0 && s.covariance == nil
+}
+
+// withLinear returns a copy of the set with an extra linear constraint
+func (s feasibleSet) withLinear(constraint linearConstraint) feasibleSet {
+	s.linear = append(append([]linearConstraint(nil), s.linear...), constraint)
+	return s
+}
+
+// violation returns the largest constraint violation at w beyond the capped simplex
+// and the name of the violated constraint
+func (s *feasibleSet) violation(w []float64) (float64, string) {
+	worst, name := 0.0, ""
+	for _, constraint := range s.linear {
+		if excess := dot(constraint.a, w) - constraint.b; excess > worst {
+			worst, name = excess, constraint.name
+		}
+	}
+	if s.covariance != nil {
+		if excess := dot(w, matVec(s.covariance, w)) - s.maxVariance; excess > worst {
+			worst, name = excess, "max_volatility"
+		}
+	}
+	return worst, name
+}
+
+const (
+	qpOuterIterations = 100
+	qpInnerIterations = 20000
+	qpStepTolerance   = 1e-13
+	qpFeasibility     = 1e-10
+	activeTolerance   = 1e-7
+	// qpPenaltyGrowth bounds how far a penalty may grow from its initial value
+	qpPenaltyGrowth = 1e4
+)
+
+// multipliers are the augmented Lagrangian state of the inequality constraints
+type multipliers struct {
+	linear, linearRho, linearResidual []float64
+	variance, varianceRho             float64
+	varianceResidual                  float64
+}
+
+// solve runs proximal gradient descent (FISTA) over the capped simplex. Linear and
+// variance constraints are handled with an augmented Lagrangian, and the result is
+// polished by solving the KKT conditions of the active set exactly.
+func (p *qpProblem) solve() ([]float64, error) {
+	n := len(p.lo)
+	start := make([]float64, n)
+	for i := range start {
+		start[i] = 1 / float64(n)
+	}
+	w, err := projectCappedSimplex(start, p.lo, p.hi)
+	if err != nil {
+		return nil, err
+	}
+
+	curvature := gershgorin(p.q)
+	// Penalties are scaled to the objective so neither dominates
+	scale := 10 * math.Max(math.Max(curvature, maxAbs(p.c)), 1e-6)
+	y := &multipliers{
+		linear:           make([]float64, len(p.linear)),
+		linearRho:        make([]float64, len(p.linear)),
+		linearResidual:   make([]float64, len(p.linear)),
+		varianceResidual: math.Inf(1),
+	}
+	for k, constraint := range p.linear {
+		y.linearResidual[k] = math.Inf(1)
+		if norm := dot(constraint.a, constraint.a); norm > 0 {
+			y.linearRho[k] = scale / norm
+		}
+	}
+	varianceCurvature := 0.0
+	if p.covariance != nil {
+		if varianceCurvature = gershgorin(p.covariance); varianceCurvature > 0 {
+			y.varianceRho = scale / (4 * varianceCurvature * varianceCurvature)
+		}
+	}
+
+	initialRho := append(append([]float64(nil), y.linearRho...), y.varianceRho)
+
+	if curvature <= 0 && p.simple() && p.c == nil && p.cost == nil {
+		// A zero objective: every feasible point is optimal
+		return w, nil
+	}
+
+	for outer := 0; outer < qpOuterIterations; outer++ {
+		// Bound the curvature of the smooth part over the simplex, where ‖w‖ ≤ 1
+		lipschitz := curvature
+		for k, constraint := range p.linear {
+			lipschitz += y.linearRho[k] * dot(constraint.a, constraint.a)
+		}
+		if p.covariance != nil {
+			pull := math.Max(0, y.variance+y.varianceRho*(varianceCurvature-p.maxVariance))
+			lipschitz += y.varianceRho*4*varianceCurvature*varianceCurvature + 2*varianceCurvature*pull
+		}
+		if lipschitz <= 0 {
+			// A linear objective: any step size converges
+			lipschitz = 1
+		}
+
+		if w, err = p.minimizeInner(w, y, lipschitz); err != nil {
+			return nil, err
+		}
+		if p.simple() {
+			break
+		}
+
+		converged := true
+		update := func(multiplier, rho, residual *float64, initialRho, violation float64) {
+			if *rho <= 0 {
+				return
+			}
+			*multiplier = math.Max(0, *multiplier+*rho*violation)
+			if violation > qpFeasibility || (*multiplier > 0 && violation < -qpFeasibility) {
+				converged = false
+			}
+			// Raise the penalty when infeasibility or slack with a multiplier is not
+			// shrinking fast enough
+			next := math.Abs(math.Min(-violation, *multiplier / *rho))
+			if next > qpFeasibility && next > 0.25**residual && *rho < initialRho*qpPenaltyGrowth {
+				*rho *= 10
+			}
+			*residual = next
+		}
+		for k, constraint := range p.linear {
+			update(&y.linear[k], &y.linearRho[k], &y.linearResidual[k], initialRho[k], dot(constraint.a, w)-constraint.b)
+		}
+		if p.covariance != nil {
+			update(&y.variance, &y.varianceRho, &y.varianceResidual, initialRho[len(p.linear)], dot(w, matVec(p.covariance, w))-p.maxVariance)
+		}
+		if converged {
+			break
+		}
+	}
+
+	if polished, ok := p.polish(w, y); ok {
+		w = polished
+	}
+	if excess, name := p.violation(w); excess > activeTolerance {
+		return nil, &InfeasibleError{Constraint: name, Detail: fmt.Sprintf("%s could not be met (exceeded by %.3g)", name, excess)}
+	}
+	return w, nil
+}
+
+// minimizeInner minimizes the augmented Lagrangian for fixed multipliers
+func (p *qpProblem) minimizeInner(w []float64, y *multipliers, lipschitz float64) ([]float64, error) {
+	n := len(w)
+	x := append([]float64(nil), w...)
+	z := append([]float64(nil), w...)
+	step := make([]float64, n)
+	t := 1.0
+
+	for iteration := 0; iteration < qpInnerIterations; iteration++ {
+		gradient := p.gradient(z, y)
+		for i := range step {
+			step[i] = z[i] - gradient[i]/lipschitz
+		}
+		next, err := proxCappedSimplex(step, p.lo, p.hi, p.cost, 1/lipschitz)
+		if err != nil {
+			return nil, err
+		}
+
+		tNext := (1 + math.Sqrt(1+4*t*t)) / 2
+		change := 0.0
+		for i := range z {
+			change = math.Max(change, math.Abs(next[i]-x[i]))
+			z[i] = next[i] + (t-1)/tNext*(next[i]-x[i])
+		}
+		x, t = next, tNext
+
+		if change < qpStepTolerance {
+			break
+		}
+	}
+
+	return x, nil
+}
+
+// gradient of ½ wᵀQw − cᵀw + Σ (1/2ρ)(max(0, y + ρg(w))² − y²) over the inequality
+// constraints g(w) ≤ 0
+func (p *qpProblem) gradient(w []float64, y *multipliers) []float64 {
+	gradient := p.quadratic(w)
+	if p.c != nil {
+		for i := range gradient {
+			gradient[i] -= p.c[i]
+		}
+	}
+	for k, constraint := range p.linear {
+		if y.linearRho[k] <= 0 {
+			continue
+		}
+		if pull := y.linear[k] + y.linearRho[k]*(dot(constraint.a, w)-constraint.b); pull > 0 {
+			for i := range gradient {
+				gradient[i] += pull * constraint.a[i]
+			}
+		}
+	}
+	if p.covariance != nil && y.varianceRho > 0 {
+		sigmaW := matVec(p.covariance, w)
+		if pull := y.variance + y.varianceRho*(dot(w, sigmaW)-p.maxVariance); pull > 0 {
+			for i := range gradient {
+				gradient[i] += pull * 2 * sigmaW[i]
+			}
+		}
+	}
+	return gradient
+}
+
+// polish takes the bounds active at w, and weights left at their trading cost anchor,
+// as fixed and solves the remaining equality constrained problem exactly, with the
+// linear constraints that carry a multiplier held as equalities. The polished point is
+// used only if it is feasible and no worse than w, so a misidentified active set
+// cannot make the solution worse. A binding variance constraint is not linear, so w is
+// kept as it is.
+func (p *qpProblem) polish(w []float64, y *multipliers) ([]float64, bool) {
+	if y.variance > 0 {
+		return nil, false
+	}
+
+	n := len(w)
+	fixed := make([]bool, n)
+	base := make([]float64, n)
+	linear := make([]float64, n)
+	var free []int
+	for i := range w {
+		switch {
+		case w[i] <= p.lo[i]+activeTolerance:
+			fixed[i], base[i] = true, p.lo[i]
+		case w[i] >= p.hi[i]-activeTolerance:
+			fixed[i], base[i] = true, p.hi[i]
+		case p.cost != nil && math.Abs(w[i]-p.cost.anchor[i]) <= activeTolerance:
+			fixed[i], base[i] = true, p.cost.anchor[i]
+		default:
+			free = append(free, i)
+		}
+
+		if p.c != nil {
+			linear[i] = p.c[i]
+		}
+		// On a free weight the trading cost is linear with the sign of the trade
+		if p.cost != nil && !fixed[i] {
+			if w[i] > p.cost.anchor[i] {
+				linear[i] -= p.cost.entry[i]
+			} else {
+				linear[i] += p.cost.exit[i]
+			}
+		}
+	}
+	if len(free) == 0 {
+		return nil, false
+	}
+
+	// Equality constraints on the free weights: Σw = 1 and the active aᵀw = b
+	sumRow := make([]float64, n)
+	for i := range sumRow {
+		sumRow[i] = 1
+	}
+	constraints := []linearConstraint{{a: sumRow, b: 1}}
+	for k, constraint := range p.linear {
+		if y.linear[k] > 0 {
+			constraints = append(constraints, constraint)
+		}
+	}
+
+	// KKT system: [Q_FF Aᵀ; A 0] [w_F; ν] = [c_F − Q_FB w_B; b − A_B w_B]
+	size := len(free) + len(constraints)
+	system := make([][]float64, size)
+	rhs := make([]float64, size)
+	for a, i := range free {
+		system[a] = make([]float64, size)
+		for b, j := range free {
+			if p.q != nil {
+				system[a][b] = p.q[i][j]
+			}
+		}
+		for k, con := range constraints {
+			system[a][len(free)+k] = con.a[i]
+		}
+		rhs[a] = linear[i]
+		for j := range base {
+			if fixed[j] && p.q != nil {
+				rhs[a] -= p.q[i][j] * base[j]
+			}
+		}
+	}
+	for k, con := range constraints {
+		row := make([]float64, size)
+		for b, j := range free {
+			row[b] = con.a[j]
+		}
+		system[len(free)+k] = row
+		rhs[len(free)+k] = con.b
+		for j := range base {
+			if fixed[j] {
+				rhs[len(free)+k] -= con.a[j] * base[j]
+			}
+		}
+	}
+
+	solution, err := solveLinear(system, rhs)
+	if err != nil {
+		return nil, false
+	}
+
+	polished := base
+	for a, i := range free {
+		if solution[a] < p.lo[i]-qpFeasibility || solution[a] > p.hi[i]+qpFeasibility {
+			return nil, false
+		}
+		// A free weight must stay on the side of the anchor its trading cost assumed
+		if p.cost != nil && (solution[a]-p.cost.anchor[i])*(w[i]-p.cost.anchor[i]) < 0 {
+			return nil, false
+		}
+		polished[i] = clamp(solution[a], p.lo[i], p.hi[i])
+	}
+	if excess, _ := p.violation(polished); excess > qpFeasibility {
+		return nil, false
+	}
+	if p.objective(polished) > p.objective(w)+qpFeasibility {
+		return nil, false
+	}
+	return polished, true
+}
+
+// quadratic returns Qw, treating a nil Q as zero
+func (p *qpProblem) quadratic(w []float64) []float64 {
+	if p.q == nil {
+		return make([]float64, len(w))
+	}
+	return matVec(p.q, w)
+}
+
+func (p *qpProblem) objective(w []float64) float64 {
+	value := 0.5 * dot(w, p.quadratic(w))
+	if p.c != nil {
+		value -= dot(p.c, w)
+	}
+	if p.cost != nil {
+		value += p.cost.value(w)
+	}
+	return value
+}
+
+// solveLinear solves a square system by Gaussian elimination with partial pivoting
+func solveLinear(a [][]float64, b []float64) ([]float64, error) {
+	n := len(b)
+	m := make([][]float64, n)
+	for i := range a {
+		m[i] = append(append([]float64(nil), a[i]...), b[i])
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(m[row][col]) > math.Abs(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(m[pivot][col]) < 1e-14 {
+			return nil, errors.New("singular system")
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+
+		for row := col + 1; row < n; row++ {
+			factor := m[row][col] / m[col][col]
+			for k := col; k <= n; k++ {
+				m[row][k] -= factor * m[col][k]
+			}
+		}
+	}
+
+	x := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := m[row][n]
+		for k := row + 1; k < n; k++ {
+			sum -= m[row][k] * x[k]
+		}
+		x[row] = sum / m[row][row]
+	}
+	return x, nil
+}
+
+// gershgorin bounds the largest eigenvalue of a symmetric matrix by its largest
+// absolute row sum
+func gershgorin(m [][]float64) float64 {
+	bound := 0.0
+	for _, row := range m {
+		sum := 0.0
+		for _, value := range row {
+			sum += math.Abs(value)
+		}
+		bound = math.Max(bound, sum)
+	}
+	return bound
+}
+
+func maxAbs(v []float64) float64 {
+	largest := 0.0
+	for _, value := range v {
+		largest = math.Max(largest, math.Abs(value))
+	}
+	return largest
+}
+
+func matVec(m [][]float64, v []float64) []float64 {
+	out := make([]float64, len(m))
+	for i, row := range m {
+		out[i] = dot(row, v)
+	}
+	return out
+}
+
+func dot(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
@@ -0,0 +1,155 @@
+package solver
+
+import (
+	"fmt"
+	"math"
+)
+
+// ReturnModel selects how expected returns are adjusted for prediction uncertainty
+type ReturnModel string
+
+const (
+	// ReturnsPredicted uses ExpectedReturn as given
+	ReturnsPredicted ReturnModel = "predicted"
+	// ReturnsBlackLitterman treats each ExpectedReturn as a view on PriorReturn held
+	// with the strategy's Confidence, and uses the Black-Litterman posterior
+	ReturnsBlackLitterman ReturnModel = "black_litterman"
+	// ReturnsWorstCase uses ReturnLowerBound. For long-only portfolios this is the
+	// worst case of any return inside the prediction intervals, so the allocation is
+	// robust to every outcome the intervals allow.
+	ReturnsWorstCase ReturnModel = "worst_case"
+)
+
+// ReturnConfig configures AdjustReturns
+type ReturnConfig struct {
+	Model ReturnModel
+
+	// Covariance and Correlation are used as in MeanVarianceConfig. Black-Litterman
+	// views spill over to correlated strategies; uncorrelated strategies are adjusted
+	// independently.
+	Covariance  [][]float64
+	Correlation [][]float64
+}
+
+// AdjustReturns returns a copy of the strategies with ExpectedReturn replaced by the
+// configured model's estimate, so that any optimizer can use it. Low-confidence or
+// wide-interval predictions move the estimate toward the prior or the interval's lower
+// end, and cannot swing allocations on their own.
+func AdjustReturns(strategies []StrategyInput, config ReturnConfig) ([]StrategyInput, error) {
+	adjusted := append([]StrategyInput(nil), strategies...)
+
+	for _, strategy := range strategies {
+		for _, value := range []float64{strategy.ExpectedReturn, strategy.PriorReturn, strategy.ReturnLowerBound} {
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				return nil, fmt.Errorf("strategy %s: returns must be finite numbers", strategy.Name)
+			}
+		}
+		if math.IsNaN(strategy.Confidence) || strategy.Confidence < 0 || strategy.Confidence > 1 {
+			return nil, fmt.Errorf("strategy %s: confidence must be between 0 and 1, got %v", strategy.Name, strategy.Confidence)
+		}
+	}
+
+	switch config.Model {
+	case ReturnsPredicted, "":
+	case ReturnsWorstCase:
+		for i := range adjusted {
+			adjusted[i].ExpectedReturn = math.Min(adjusted[i].ReturnLowerBound, adjusted[i].ExpectedReturn)
+		}
+	case ReturnsBlackLitterman:
+		covariance, err := covarianceMatrix(strategies, config.Covariance, config.Correlation)
+		if err != nil {
+			return nil, err
+		}
+		posterior, err := blackLitterman(strategies, covariance)
+		if err != nil {
+			return nil, err
+		}
+		for i := range adjusted {
+			adjusted[i].ExpectedReturn = posterior[i]
+		}
+	default:
+		return nil, fmt.Errorf("unknown return model %q (expected predicted, black_litterman or worst_case)", config.Model)
+	}
+
+	return adjusted, nil
+}
+
+// blackLitterman returns the posterior expected returns for absolute views q = the
+// predicted returns on a prior π:
+//
+//	μ = π + Σ_·V (Σ_VV + Ω)⁻¹ (q_V − π_V)
+//
+// with view uncertainty Ω_ii = Σ_ii (1 − cᵢ) / cᵢ for confidence cᵢ (Idzorek), so a
+// view held with confidence c moves an uncorrelated strategy's return the fraction c
+// of the way from the prior to the prediction. The scale of the prior covariance
+// cancels out and is omitted. Views without confidence are dropped; strategies with
+// zero variance carry no covariance information and are shrunk on their own.
+//
+// Views held with confidence 1 have Ω_ii = 0 and are met exactly. Between perfectly
+// correlated strategies such views make Σ_VV + Ω singular: the ones the others already
+// determine are left out of the system, and an error is returned if they disagree.
+func blackLitterman(strategies []StrategyInput, covariance [][]float64) ([]float64, error) {
+	posterior := make([]float64, len(strategies))
+	var views []int
+	for i, strategy := range strategies {
+		posterior[i] = strategy.PriorReturn
+		switch {
+		case strategy.Confidence == 0:
+		case covariance[i][i] == 0:
+			posterior[i] += strategy.Confidence * (strategy.ExpectedReturn - strategy.PriorReturn)
+		default:
+			views = append(views, i)
+		}
+	}
+	if len(views) == 0 {
+		return posterior, nil
+	}
+
+	weights, err := solveViews(strategies, covariance, views)
+	if err != nil {
+		// Keep the views that still leave the system solvable
+		var independent []int
+		for _, i := range views {
+			candidate := append(append([]int(nil), independent...), i)
+			if _, err := solveViews(strategies, covariance, candidate); err == nil {
+				independent = candidate
+			}
+		}
+		views = independent
+		if weights, err = solveViews(strategies, covariance, views); err != nil {
+			return nil, fmt.Errorf("failed to combine return views: %w", err)
+		}
+	}
+	for i := range posterior {
+		if covariance[i][i] == 0 {
+			continue
+		}
+		for a, j := range views {
+			posterior[i] += covariance[i][j] * weights[a]
+		}
+	}
+
+	for i, strategy := range strategies {
+		if strategy.Confidence == 1 && math.Abs(posterior[i]-strategy.ExpectedReturn) > 1e-9 {
+			return nil, fmt.Errorf("strategy %s: certain view of %v conflicts with the %v implied by certain views on correlated strategies",
+				strategy.Name, strategy.ExpectedReturn, posterior[i])
+		}
+	}
+	return posterior, nil
+}
+
+// solveViews solves (Σ_VV + Ω) x = q_V − π_V for the given views
+func solveViews(strategies []StrategyInput, covariance [][]float64, views []int) ([]float64, error) {
+	system := make([][]float64, len(views))
+	surprise := make([]float64, len(views))
+	for a, i := range views {
+		system[a] = make([]float64, len(views))
+		for b, j := range views {
+			system[a][b] = covariance[i][j]
+		}
+		confidence := strategies[i].Confidence
+		system[a][a] += covariance[i][i] * (1 - confidence) / confidence
+		surprise[a] = strategies[i].ExpectedReturn - strategies[i].PriorReturn
+	}
+	return solveLinear(system, surprise)
+}
@@ -0,0 +1,93 @@
+package solver
+
+import (
+	"math"
+	"testing"
+)
+
+// view is a strategy predicted to return predicted against a prior, with confidence
+func view(name string, prior, predicted, confidence float64) StrategyInput {
+	s := strategy(name, predicted, 0.1)
+	s.PriorReturn, s.Confidence = prior, confidence
+	return s
+}
+
+func TestAdjustReturnsBlackLitterman(t *testing.T) {
+	tests := []struct {
+		name        string
+		strategies  []StrategyInput
+		correlation [][]float64
+		want        []float64
+	}{
+		{
+			name:       "confidence moves the return that fraction of the way",
+			strategies: []StrategyInput{view("a", 0.05, 0.10, 0.3), view("b", 0.04, 0.02, 0.75)},
+			want:       []float64{0.05 + 0.3*0.05, 0.04 - 0.75*0.02},
+		},
+		{
+			name:       "zero confidence keeps the prior",
+			strategies: []StrategyInput{view("a", 0.05, 0.10, 0), view("b", 0.04, 0.02, 0)},
+			want:       []float64{0.05, 0.04},
+		},
+		{
+			name:       "full confidence takes the prediction",
+			strategies: []StrategyInput{view("a", 0.05, 0.10, 1), view("b", 0.04, 0.02, 1)},
+			want:       []float64{0.10, 0.02},
+		},
+		{
+			// b has no view of its own and moves by ρ times a's adjustment
+			name:        "view spills over to a correlated strategy",
+			strategies:  []StrategyInput{view("a", 0.05, 0.09, 0.5), view("b", 0.04, 0.08, 0)},
+			correlation: [][]float64{{1, 0.5}, {0.5, 1}},
+			want:        []float64{0.05 + 0.5*0.04, 0.04 + 0.5*0.5*0.04},
+		},
+		{
+			// Certain views on perfectly correlated strategies leave Σ_VV + Ω singular
+			name:        "agreeing certain views on perfectly correlated strategies",
+			strategies:  []StrategyInput{view("a", 0.05, 0.07, 1), view("b", 0.04, 0.06, 1), view("c", 0.03, 0.03, 0)},
+			correlation: [][]float64{{1, 1, 0.5}, {1, 1, 0.5}, {0.5, 0.5, 1}},
+			want:        []float64{0.07, 0.06, 0.03 + 0.5*0.02},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adjusted, err := AdjustReturns(tt.strategies, ReturnConfig{Model: ReturnsBlackLitterman, Correlation: tt.correlation})
+			if err != nil {
+				t.Fatalf("AdjustReturns: %v", err)
+			}
+			for i, want := range tt.want {
+				if got := adjusted[i].ExpectedReturn; math.Abs(got-want) > 1e-12 {
+					t.Errorf("%s return = %v, want %v", adjusted[i].Name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAdjustReturnsConflictingCertainViews(t *testing.T) {
+	strategies := []StrategyInput{view("a", 0.05, 0.07, 1), view("b", 0.05, 0.09, 1)}
+	correlation := [][]float64{{1, 1}, {1, 1}}
+
+	if _, err := AdjustReturns(strategies, ReturnConfig{Model: ReturnsBlackLitterman, Correlation: correlation}); err == nil {
+		t.Fatal("AdjustReturns combined certain views that disagree on perfectly correlated strategies")
+	}
+}
+
+func TestAdjustReturnsWorstCase(t *testing.T) {
+	strategies := []StrategyInput{strategy("a", 0.08, 0.1), strategy("b", 0.05, 0.1)}
+	strategies[0].ReturnLowerBound = 0.03
+	strategies[1].ReturnLowerBound = 0.06 // An interval that does not contain the prediction
+
+	adjusted, err := AdjustReturns(strategies, ReturnConfig{Model: ReturnsWorstCase})
+	if err != nil {
+		t.Fatalf("AdjustReturns: %v", err)
+	}
+	if adjusted[0].ExpectedReturn != 0.03 || adjusted[1].ExpectedReturn != 0.05 {
+		t.Errorf("returns = %v, %v; want the lower of bound and prediction, 0.03 and 0.05",
+			adjusted[0].ExpectedReturn, adjusted[1].ExpectedReturn)
+	}
+	if strategies[0].ExpectedReturn != 0.08 {
+		t.Error("AdjustReturns modified its input")
+	}
+}
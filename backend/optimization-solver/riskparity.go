@@ -0,0 +1,246 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// RiskParityMethod selects how OptimizeRiskParity spreads risk across strategies
+type RiskParityMethod string
+
+const (
+	// RiskParityInverseVolatility weights each strategy by 1 / volatility
+	RiskParityInverseVolatility RiskParityMethod = "inverse_volatility"
+	// RiskParityEqualContribution gives every strategy the same share of portfolio
+	// variance, wᵢ(Σw)ᵢ equal for all i
+	RiskParityEqualContribution RiskParityMethod = "equal_risk_contribution"
+	// RiskParityHierarchical is hierarchical risk parity: strategies are clustered by
+	// correlation and risk is split between clusters by recursive bisection
+	RiskParityHierarchical RiskParityMethod = "hrp"
+)
+
+// RiskParityConfig configures OptimizeRiskParity
+type RiskParityConfig struct {
+	Method RiskParityMethod
+
+	// Covariance and Correlation are used as in MeanVarianceConfig
+	Covariance  [][]float64
+	Correlation [][]float64
+}
+
+const (
+	ercMaxSweeps = 10000
+	ercTolerance = 1e-12
+)
+
+// OptimizeRiskParity calculates an allocation that ignores expected returns and
+// spreads risk instead. The risk parity weights are then moved onto the solver's
+// constraint set as in Optimize, so binding constraints trade some risk balance for
+// feasibility. Every strategy must have a positive variance.
+func (os *OptimizationSolver) OptimizeRiskParity(
+	strategies []StrategyInput,
+	totalAssets float64,
+	config RiskParityConfig,
+) ([]AllocationResult, error) {
+	if len(strategies) == 0 {
+		return nil, errors.New("no strategies provided")
+	}
+
+	covariance, err := covarianceMatrix(strategies, config.Covariance, config.Correlation)
+	if err != nil {
+		return nil, err
+	}
+	for i := range covariance {
+		if covariance[i][i] <= 0 {
+			return nil, fmt.Errorf("strategy %s has zero variance; risk parity needs every strategy to carry risk", strategies[i].Name)
+		}
+	}
+
+	var weights []float64
+	switch config.Method {
+	case RiskParityInverseVolatility:
+		weights = inverseVolatility(covariance)
+	case RiskParityEqualContribution:
+		weights, err = equalRiskContribution(covariance)
+	case RiskParityHierarchical:
+		weights = hierarchicalRiskParity(covariance)
+	default:
+		return nil, fmt.Errorf("unknown risk parity method %q (expected inverse_volatility, equal_risk_contribution or hrp)", config.Method)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if weights, err = os.constrain(strategies, covariance, weights); err != nil {
+		return nil, err
+	}
+	return allocationResults(strategies, weights, totalAssets), nil
+}
+
+// inverseVolatility weights each strategy by 1 / σᵢ
+func inverseVolatility(covariance [][]float64) []float64 {
+	weights := make([]float64, len(covariance))
+	for i := range covariance {
+		weights[i] = 1 / math.Sqrt(covariance[i][i])
+	}
+	return normalize(weights)
+}
+
+// equalRiskContribution finds the weights whose risk contributions wᵢ(Σw)ᵢ are equal
+// by cyclical coordinate descent on ½yᵀΣy − (1/n)Σ ln yᵢ, whose minimizer normalized
+// to sum to 1 is the equal risk contribution portfolio. Each coordinate step solves
+// its quadratic exactly.
+func equalRiskContribution(covariance [][]float64) ([]float64, error) {
+	n := len(covariance)
+	budget := 1 / float64(n)
+	y := inverseVolatility(covariance)
+
+	for sweep := 0; sweep < ercMaxSweeps; sweep++ {
+		change := 0.0
+		for i := range y {
+			cross := 0.0
+			for j := range y {
+				if j != i {
+					cross += covariance[i][j] * y[j]
+				}
+			}
+			next := (-cross + math.Sqrt(cross*cross+4*covariance[i][i]*budget)) / (2 * covariance[i][i])
+			change = math.Max(change, math.Abs(next-y[i])/next)
+			y[i] = next
+		}
+		if change < ercTolerance {
+			return normalize(y), nil
+		}
+	}
+	return nil, errors.New("equal risk contribution weights did not converge")
+}
+
+// hierarchicalRiskParity clusters strategies by single linkage on their correlation
+// distance, orders them so correlated strategies are adjacent, and splits weight
+// between the halves of the ordering in inverse proportion to their variance
+func hierarchicalRiskParity(covariance [][]float64) []float64 {
+	order := quasiDiagonal(correlationDistance(covariance))
+
+	weights := make([]float64, len(covariance))
+	for _, i := range order {
+		weights[i] = 1
+	}
+
+	var bisect func(items []int)
+	bisect = func(items []int) {
+		if len(items) < 2 {
+			return
+		}
+		left, right := items[:len(items)/2], items[len(items)/2:]
+		leftVariance := clusterVariance(covariance, left)
+		rightVariance := clusterVariance(covariance, right)
+		alpha := 0.5
+		if total := leftVariance + rightVariance; total > 0 {
+			alpha = 1 - leftVariance/total
+		}
+		for _, i := range left {
+			weights[i] *= alpha
+		}
+		for _, i := range right {
+			weights[i] *= 1 - alpha
+		}
+		bisect(left)
+		bisect(right)
+	}
+	bisect(order)
+
+	return weights
+}
+
+// correlationDistance returns the distance between strategies' correlation distance
+// profiles, where the correlation distance is √((1 − ρ) / 2)
+func correlationDistance(covariance [][]float64) [][]float64 {
+	n := len(covariance)
+	distance := make([][]float64, n)
+	for i := range distance {
+		distance[i] = make([]float64, n)
+		for j := range distance[i] {
+			rho := covariance[i][j] / math.Sqrt(covariance[i][i]*covariance[j][j])
+			distance[i][j] = math.Sqrt(math.Max(0, (1-clamp(rho, -1, 1))/2))
+		}
+	}
+
+	profile := make([][]float64, n)
+	for i := range profile {
+		profile[i] = make([]float64, n)
+		for j := range profile[i] {
+			sum := 0.0
+			for k := range distance {
+				delta := distance[k][i] - distance[k][j]
+				sum += delta * delta
+			}
+			profile[i][j] = math.Sqrt(sum)
+		}
+	}
+	return profile
+}
+
+// quasiDiagonal clusters strategies by single linkage and returns the leaves of the
+// dendrogram in order. Ties merge the clusters with the lowest indices first, so the
+// order is deterministic.
+func quasiDiagonal(distance [][]float64) []int {
+	clusters := make([][]int, len(distance))
+	for i := range clusters {
+		clusters[i] = []int{i}
+	}
+
+	linkage := func(a, b []int) float64 {
+		closest := math.Inf(1)
+		for _, i := range a {
+			for _, j := range b {
+				closest = math.Min(closest, distance[i][j])
+			}
+		}
+		return closest
+	}
+
+	for len(clusters) > 1 {
+		bestA, bestB, best := 0, 1, math.Inf(1)
+		for a := range clusters {
+			for b := a + 1; b < len(clusters); b++ {
+				if d := linkage(clusters[a], clusters[b]); d < best {
+					bestA, bestB, best = a, b, d
+				}
+			}
+		}
+		merged := append(append([]int(nil), clusters[bestA]...), clusters[bestB]...)
+		clusters[bestA] = merged
+		clusters = append(clusters[:bestB], clusters[bestB+1:]...)
+	}
+	return clusters[0]
+}
+
+// clusterVariance is the variance of the inverse-variance portfolio of a cluster
+func clusterVariance(covariance [][]float64, items []int) float64 {
+	weights := make([]float64, len(items))
+	for a, i := range items {
+		weights[a] = 1 / covariance[i][i]
+	}
+	weights = normalize(weights)
+
+	variance := 0.0
+	for a, i := range items {
+		for b, j := range items {
+			variance += weights[a] * covariance[i][j] * weights[b]
+		}
+	}
+	return variance
+}
+
+// normalize scales positive weights to sum to 1
+func normalize(weights []float64) []float64 {
+	total := 0.0
+	for _, weight := range weights {
+		total += weight
+	}
+	for i := range weights {
+		weights[i] /= total
+	}
+	return weights
+}
@@ -0,0 +1,186 @@
+package solver
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestEqualRiskContribution(t *testing.T) {
+	strategies := []StrategyInput{
+		strategy("a", 0.08, 0.10),
+		strategy("b", 0.12, 0.15),
+		strategy("c", 0.15, 0.20),
+		strategy("d", 0.10, 0.25),
+	}
+
+	tests := []struct {
+		name   string
+		config RiskParityConfig
+	}{
+		{
+			name: "covariance",
+			config: RiskParityConfig{
+				Method: RiskParityEqualContribution,
+				Covariance: [][]float64{
+					{0.0100, 0.0090, 0.0010, -0.0020},
+					{0.0090, 0.0225, 0.0060, 0.0015},
+					{0.0010, 0.0060, 0.0400, 0.0200},
+					{-0.0020, 0.0015, 0.0200, 0.0625},
+				},
+			},
+		},
+		{
+			name: "correlation",
+			config: RiskParityConfig{
+				Method: RiskParityEqualContribution,
+				Correlation: [][]float64{
+					{1, 0.6, 0.1, -0.1},
+					{0.6, 1, 0.2, 0.0},
+					{0.1, 0.2, 1, 0.4},
+					{-0.1, 0.0, 0.4, 1},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := unconstrainedSolver(t).OptimizeRiskParity(strategies, 1000, tt.config)
+			if err != nil {
+				t.Fatalf("OptimizeRiskParity: %v", err)
+			}
+			covariance, err := covarianceMatrix(strategies, tt.config.Covariance, tt.config.Correlation)
+			if err != nil {
+				t.Fatalf("covarianceMatrix: %v", err)
+			}
+
+			weights := make([]float64, len(results))
+			total := 0.0
+			for i, result := range results {
+				weights[i] = result.Weight
+				total += result.Weight
+			}
+			if math.Abs(total-1) > 1e-9 {
+				t.Fatalf("weights sum to %v, want 1", total)
+			}
+
+			// Each strategy's share of portfolio variance, wᵢ(Σw)ᵢ / wᵀΣw, is 1/n
+			marginal := matVec(covariance, weights)
+			variance := dot(weights, marginal)
+			for i, result := range results {
+				share := weights[i] * marginal[i] / variance
+				if math.Abs(share-1/float64(len(results))) > 1e-6 {
+					t.Errorf("risk contribution of %s = %v, want %v", result.Strategy, share, 1/float64(len(results)))
+				}
+			}
+
+			// Correlations must move the weights away from inverse volatility
+			inverse := inverseVolatility(covariance)
+			if math.Abs(weights[0]-inverse[0]) < 1e-3 {
+				t.Errorf("weight of a = %v, the inverse volatility weight; correlations were ignored", weights[0])
+			}
+		})
+	}
+}
+
+// blockStrategies are two blocks, {a, c} and {b, d}, correlated 0.9 within and 0.1
+// across, with the second block twice as volatile
+func blockStrategies() ([]StrategyInput, [][]float64) {
+	strategies := []StrategyInput{
+		strategy("a", 0.05, 0.1),
+		strategy("b", 0.05, 0.2),
+		strategy("c", 0.05, 0.1),
+		strategy("d", 0.05, 0.2),
+	}
+	correlation := [][]float64{
+		{1, 0.1, 0.9, 0.1},
+		{0.1, 1, 0.1, 0.9},
+		{0.9, 0.1, 1, 0.1},
+		{0.1, 0.9, 0.1, 1},
+	}
+	return strategies, correlation
+}
+
+func TestHierarchicalRiskParity(t *testing.T) {
+	strategies, correlation := blockStrategies()
+	covariance, err := covarianceMatrix(strategies, nil, correlation)
+	if err != nil {
+		t.Fatalf("covarianceMatrix: %v", err)
+	}
+
+	// Correlated strategies are adjacent; the tie between the blocks merges {a, c} first
+	order := quasiDiagonal(correlationDistance(covariance))
+	if want := []int{0, 2, 1, 3}; !reflect.DeepEqual(order, want) {
+		t.Errorf("quasi-diagonal order = %v, want %v", order, want)
+	}
+
+	// The blocks' inverse-variance portfolios have variances 0.0095 and 0.038, so the
+	// first gets 1 − 0.0095/0.0475 = 0.8, split evenly within each block
+	want := []float64{0.4, 0.1, 0.4, 0.1}
+	weights := hierarchicalRiskParity(covariance)
+	for i := range want {
+		if math.Abs(weights[i]-want[i]) > 1e-12 {
+			t.Fatalf("weights = %v, want %v", weights, want)
+		}
+	}
+}
+
+func TestOptimizeRiskParityConstrained(t *testing.T) {
+	strategies, correlation := blockStrategies()
+
+	tests := []struct {
+		name        string
+		method      RiskParityMethod
+		constraints ConstraintSet
+		want        []float64
+	}{
+		{
+			// Weights proportional to 1/σ: 10, 5, 10, 5
+			name:        "inverse volatility",
+			method:      RiskParityInverseVolatility,
+			constraints: ConstraintSet{MaxAllocation: 1},
+			want:        []float64{1.0 / 3, 1.0 / 6, 1.0 / 3, 1.0 / 6},
+		},
+		{
+			name:        "hrp unconstrained",
+			method:      RiskParityHierarchical,
+			constraints: ConstraintSet{MaxAllocation: 1},
+			want:        []float64{0.4, 0.1, 0.4, 0.1},
+		},
+		{
+			// The 5% cut from a and c is spread evenly over b and d
+			name:        "hrp with a binding cap",
+			method:      RiskParityHierarchical,
+			constraints: ConstraintSet{MaxAllocation: 0.35},
+			want:        []float64{0.35, 0.15, 0.35, 0.15},
+		},
+		{
+			name:   "hrp with a binding group minimum",
+			method: RiskParityHierarchical,
+			constraints: ConstraintSet{
+				MaxAllocation: 1,
+				Groups:        []GroupConstraint{{Name: "bd", Strategies: []string{"b", "d"}, MinAllocation: 0.4}},
+			},
+			want: []float64{0.3, 0.2, 0.3, 0.2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			optimizer, err := NewConstrainedSolver(&tt.constraints)
+			if err != nil {
+				t.Fatalf("NewConstrainedSolver: %v", err)
+			}
+			results, err := optimizer.OptimizeRiskParity(strategies, 1000, RiskParityConfig{Method: tt.method, Correlation: correlation})
+			if err != nil {
+				t.Fatalf("OptimizeRiskParity: %v", err)
+			}
+			for i := range tt.want {
+				if math.Abs(results[i].Weight-tt.want[i]) > 1e-6 {
+					t.Fatalf("weights = %v, want %v", weightsOf(results), tt.want)
+				}
+			}
+		})
+	}
+}
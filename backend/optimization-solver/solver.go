@@ -2,15 +2,13 @@ package solver
 
 import (
 	"errors"
+	"fmt"
 	"math"
 )
 
 // OptimizationSolver implements portfolio optimization
 type OptimizationSolver struct {
-	// Solver parameters
-	riskTolerance float64
-	minAllocation float64
-	maxAllocation float64
+	constraints ConstraintSet
 }
 
 // StrategyInput represents input data for a strategy
@@ -21,6 +19,16 @@ type StrategyInput struct {
 	Volatility     float64
 	RiskScore      float64
 	MaxAllocation  float64
+
+	// Trading costs, used by OptimizeWithTurnover
+	EntryCost float64 // Fraction of the amount moved into the strategy (slippage, bridge fees)
+	ExitCost  float64 // Fraction of the amount moved out of the strategy
+	FixedCost float64 // Per leg touching the strategy (gas), in the units of totalAssets
+
+	// Prediction uncertainty, used by AdjustReturns
+	Confidence       float64 // Confidence in ExpectedReturn, 0-1
+	PriorReturn      float64 // Return expected without the prediction, e.g. the current APY
+	ReturnLowerBound float64 // Lower end of ExpectedReturn's prediction interval
 }
 
 // AllocationResult represents the optimal allocation
@@ -30,16 +38,24 @@ type AllocationResult struct {
 	Weight     float64
 }
 
-// NewOptimizationSolver creates a new solver
+// NewOptimizationSolver creates a new solver with the default constraints
 func NewOptimizationSolver(riskTolerance float64) *OptimizationSolver {
-	return &OptimizationSolver{
-		riskTolerance: riskTolerance,
-		minAllocation: 0.05, // 5% minimum
-		maxAllocation: 0.50, // 50% maximum
+	return &OptimizationSolver{constraints: *DefaultConstraints(riskTolerance)}
+}
+
+// NewConstrainedSolver creates a solver that enforces the given constraint set
+func NewConstrainedSolver(constraints *ConstraintSet) (*OptimizationSolver, error) {
+	if err := constraints.Validate(nil); err != nil {
+		return nil, err
 	}
+	return &OptimizationSolver{constraints: *constraints}, nil
 }
 
-// Optimize calculates the optimal portfolio allocation
+// Optimize calculates the optimal portfolio allocation. Weights are as close as the
+// constraints allow to each strategy's share of the total risk-adjusted score, where
+// risk is shaped by the constraint set's RiskTolerance. A *ConstraintError is returned
+// when the constraints conflict, and an *InfeasibleError when no weights summing to 1
+// satisfy them.
 func (os *OptimizationSolver) Optimize(
 	strategies []StrategyInput,
 	totalAssets float64,
@@ -51,59 +67,84 @@ func (os *OptimizationSolver) Optimize(
 	// Calculate risk-adjusted scores (Sharpe ratio approximation)
 	scores := make([]float64, len(strategies))
 	totalScore := 0.0
+	// Risk is weighted from squared (tolerance 0) through linear (0.5) to ignored (1)
+	riskExponent := 2 * (1 - os.constraints.RiskTolerance)
 
 	for i, strategy := range strategies {
 		// Risk-adjusted return = (Expected Return - Risk Free Rate) / Volatility
 		// Simplified: just use return / (volatility * risk_score)
 		if strategy.Volatility > 0 && strategy.RiskScore > 0 {
-			scores[i] = strategy.ExpectedReturn / (strategy.Volatility * strategy.RiskScore / 100.0)
+			risk := strategy.Volatility * strategy.RiskScore / 100.0
+			scores[i] = strategy.ExpectedReturn / math.Pow(risk, riskExponent)
 		} else {
 			scores[i] = strategy.ExpectedReturn
 		}
+		if math.IsNaN(scores[i]) || math.IsInf(scores[i], 0) {
+			return nil, fmt.Errorf("strategy %s: score must be a finite number", strategy.Name)
+		}
 		totalScore += scores[i]
 	}
 
-	// Normalize scores to get weights
-	results := make([]AllocationResult, len(strategies))
-	
-	for i, strategy := range strategies {
-		weight := scores[i] / totalScore
-		
-		// Apply constraints
-		weight = math.Max(weight, os.minAllocation)
-		weight = math.Min(weight, os.maxAllocation)
-		weight = math.Min(weight, strategy.MaxAllocation)
-
-		allocation := weight * totalAssets
+	var covariance [][]float64
+	if os.constraints.MaxVolatility > 0 {
+		var err error
+		if covariance, err = covarianceMatrix(strategies, nil, nil); err != nil {
+			return nil, err
+		}
+	}
 
-		results[i] = AllocationResult{
-			Strategy:   strategy.Name,
-			Allocation: allocation,
-			Weight:     weight,
+	// Normalize scores to get weights, then move them onto the constraints
+	weights := make([]float64, len(strategies))
+	for i := range weights {
+		if totalScore > 0 {
+			weights[i] = scores[i] / totalScore
+		} else {
+			// No strategy has a positive score; start from an equal split
+			weights[i] = 1 / float64(len(strategies))
 		}
 	}
 
-	// Normalize weights to sum to 1.0
-	results = normalizeWeights(results, totalAssets)
+	weights, err := os.constrain(strategies, covariance, weights)
+	if err != nil {
+		return nil, err
+	}
 
-	return results, nil
+	return allocationResults(strategies, weights, totalAssets), nil
 }
 
-// normalizeWeights ensures weights sum to 1.0
-func normalizeWeights(results []AllocationResult, totalAssets float64) []AllocationResult {
-	totalWeight := 0.0
-	for _, r := range results {
-		totalWeight += r.Weight
+// constrain returns the weights closest to target (in Euclidean distance) that satisfy
+// the constraint set. The covariance is used for the volatility cap.
+func (os *OptimizationSolver) constrain(strategies []StrategyInput, covariance [][]float64, target []float64) ([]float64, error) {
+	set, err := os.constraints.feasibleSet(strategies, covariance)
+	if err != nil {
+		return nil, err
 	}
 
-	if totalWeight == 0 {
-		return results
+	if set.simple() {
+		return projectCappedSimplex(target, set.lo, set.hi)
 	}
+	problem := &qpProblem{q: identity(len(target)), c: target, feasibleSet: set}
+	return problem.solve()
+}
 
-	for i := range results {
-		results[i].Weight = results[i].Weight / totalWeight
-		results[i].Allocation = results[i].Weight * totalAssets
+// allocationResults converts weights into allocations of totalAssets
+func allocationResults(strategies []StrategyInput, weights []float64, totalAssets float64) []AllocationResult {
+	results := make([]AllocationResult, len(strategies))
+	for i, strategy := range strategies {
+		results[i] = AllocationResult{
+			Strategy:   strategy.Name,
+			Allocation: weights[i] * totalAssets,
+			Weight:     weights[i],
+		}
 	}
-
 	return results
 }
+
+func identity(n int) [][]float64 {
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+		m[i][i] = 1
+	}
+	return m
+}
@@ -0,0 +1,254 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// hoursPerYear converts the holding horizon into a fraction of a year
+const hoursPerYear = 365 * 24
+
+// TurnoverConfig configures OptimizeWithTurnover
+type TurnoverConfig struct {
+	// Horizon is how long the new allocation is expected to be held. Annual expected
+	// returns are pro-rated over it and weighed against one-off trading costs.
+	Horizon time.Duration
+	// RiskAversion weighs portfolio variance against expected return. Zero maximizes
+	// expected return net of trading costs.
+	RiskAversion float64
+	// MinTradeSize is the smallest leg worth executing, in the units of totalAssets.
+	// Smaller legs are left at the strategy's current allocation.
+	MinTradeSize float64
+
+	// Covariance and Correlation are used as in MeanVarianceConfig
+	Covariance  [][]float64
+	Correlation [][]float64
+}
+
+// TurnoverResult is a cost-aware allocation and its expected value over the horizon.
+// Amounts are in the units of totalAssets.
+type TurnoverResult struct {
+	Allocations  []AllocationResult
+	Turnover     float64 // Sum of absolute weight changes
+	Legs         int     // Strategies whose allocation changes
+	ExpectedGain float64 // Expected return improvement over the horizon
+	TradingCost  float64 // Entry, exit and fixed costs of the trades
+	NetBenefit   float64 // ExpectedGain minus TradingCost
+}
+
+// OptimizeWithTurnover calculates an allocation that trades off expected return and
+// risk against the cost of moving away from each strategy's CurrentAlloc. Proportional
+// EntryCost and ExitCost are part of the objective, so small score changes do not
+// cause churn; legs below MinTradeSize keep their current allocation, and legs whose
+// FixedCost outweighs their contribution are dropped greedily.
+func (os *OptimizationSolver) OptimizeWithTurnover(
+	strategies []StrategyInput,
+	totalAssets float64,
+	config TurnoverConfig,
+) (*TurnoverResult, error) {
+	if len(strategies) == 0 {
+		return nil, errors.New("no strategies provided")
+	}
+	if totalAssets <= 0 {
+		return nil, fmt.Errorf("total assets must be positive, got %v", totalAssets)
+	}
+	if config.Horizon <= 0 {
+		return nil, fmt.Errorf("horizon must be positive, got %s", config.Horizon)
+	}
+	if config.RiskAversion < 0 || config.MinTradeSize < 0 {
+		return nil, errors.New("risk aversion and minimum trade size must not be negative")
+	}
+
+	n := len(strategies)
+	horizon := config.Horizon.Hours() / hoursPerYear
+
+	covariance, err := covarianceMatrix(strategies, config.Covariance, config.Correlation)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &turnoverPlan{
+		strategies:  strategies,
+		totalAssets: totalAssets,
+		horizon:     horizon,
+		mu:          make([]float64, n),
+		cost: &tradeCost{
+			anchor: make([]float64, n),
+			entry:  make([]float64, n),
+			exit:   make([]float64, n),
+		},
+	}
+	if plan.set, err = os.constraints.feasibleSet(strategies, covariance); err != nil {
+		return nil, err
+	}
+
+	for i, strategy := range strategies {
+		for _, value := range []float64{strategy.ExpectedReturn, strategy.CurrentAlloc, strategy.EntryCost, strategy.ExitCost, strategy.FixedCost} {
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				return nil, fmt.Errorf("strategy %s: inputs must be finite numbers", strategy.Name)
+			}
+		}
+		if strategy.CurrentAlloc < 0 || strategy.EntryCost < 0 || strategy.ExitCost < 0 || strategy.FixedCost < 0 {
+			return nil, fmt.Errorf("strategy %s: current allocation and costs must not be negative", strategy.Name)
+		}
+
+		plan.mu[i] = strategy.ExpectedReturn
+		plan.cost.anchor[i] = strategy.CurrentAlloc
+		// The objective is per unit of horizon, so one-off costs are spread over it
+		plan.cost.entry[i] = strategy.EntryCost / horizon
+		plan.cost.exit[i] = strategy.ExitCost / horizon
+	}
+
+	plan.q = make([][]float64, n)
+	for i := range covariance {
+		plan.q[i] = make([]float64, n)
+		for j := range covariance[i] {
+			plan.q[i][j] = config.RiskAversion * covariance[i][j]
+		}
+	}
+
+	frozen := make([]bool, n)
+	weights, err := plan.solve(frozen)
+	if err != nil {
+		return nil, err
+	}
+
+	// Leave legs below the minimum trade size untouched
+	minTrade := config.MinTradeSize / totalAssets
+	for changed := true; changed; {
+		changed = false
+		candidate := append([]bool(nil), frozen...)
+		for i := range weights {
+			if !candidate[i] && plan.canFreeze(i) && math.Abs(weights[i]-plan.cost.anchor[i]) < math.Max(minTrade, weightTolerance) {
+				candidate[i], changed = true, true
+			}
+		}
+		if !changed {
+			break
+		}
+		next, err := plan.solve(candidate)
+		if err != nil {
+			// Freezing would leave the remaining legs unable to satisfy the constraints
+			break
+		}
+		frozen, weights = candidate, next
+	}
+
+	// Drop the leg whose fixed cost most outweighs its contribution until none does. The
+	// contribution includes any risk the leg takes off, not only its expected gain.
+	for {
+		best, bestWeights := -1, weights
+		bestValue := plan.value(weights)
+		for i := range weights {
+			if frozen[i] || strategies[i].FixedCost == 0 || !plan.canFreeze(i) || !plan.trades(weights, i) {
+				continue
+			}
+			candidate := append([]bool(nil), frozen...)
+			candidate[i] = true
+			next, err := plan.solve(candidate)
+			if err != nil {
+				continue
+			}
+			if value := plan.value(next); value > bestValue {
+				best, bestWeights, bestValue = i, next, value
+			}
+		}
+		if best < 0 {
+			break
+		}
+		frozen[best], weights = true, bestWeights
+	}
+
+	return plan.evaluate(weights), nil
+}
+
+// turnoverPlan is the problem solved by OptimizeWithTurnover
+type turnoverPlan struct {
+	strategies  []StrategyInput
+	totalAssets float64
+	horizon     float64 // Years
+	q           [][]float64
+	mu          []float64
+	cost        *tradeCost
+	set         feasibleSet
+}
+
+// solve maximizes the net objective with the frozen strategies held at their current
+// allocation
+func (p *turnoverPlan) solve(frozen []bool) ([]float64, error) {
+	set := p.set
+	set.lo = append([]float64(nil), p.set.lo...)
+	set.hi = append([]float64(nil), p.set.hi...)
+	for i := range frozen {
+		if frozen[i] {
+			set.lo[i], set.hi[i] = p.cost.anchor[i], p.cost.anchor[i]
+		}
+	}
+
+	problem := &qpProblem{q: p.q, c: p.mu, cost: p.cost, feasibleSet: set}
+	weights, err := problem.solve()
+	if err != nil {
+		return nil, err
+	}
+	for i := range frozen {
+		if frozen[i] {
+			weights[i] = p.cost.anchor[i]
+		}
+	}
+	return weights, nil
+}
+
+// canFreeze reports whether strategy i may stay at its current allocation
+func (p *turnoverPlan) canFreeze(i int) bool {
+	return p.cost.anchor[i] >= p.set.lo[i] && p.cost.anchor[i] <= p.set.hi[i]
+}
+
+// trades reports whether the allocation of strategy i changes
+func (p *turnoverPlan) trades(weights []float64, i int) bool {
+	return math.Abs(weights[i]-p.cost.anchor[i]) > weightTolerance
+}
+
+// value is the full objective of an allocation over the horizon, in the units of
+// totalAssets: expected return less the variance penalty and every trading cost
+func (p *turnoverPlan) value(weights []float64) float64 {
+	problem := &qpProblem{q: p.q, c: p.mu, cost: p.cost}
+	value := -problem.objective(weights) * p.horizon * p.totalAssets
+	for i, strategy := range p.strategies {
+		if p.trades(weights, i) {
+			value -= strategy.FixedCost
+		}
+	}
+	return value
+}
+
+// evaluate prices an allocation over the horizon
+func (p *turnoverPlan) evaluate(weights []float64) *TurnoverResult {
+	result := &TurnoverResult{Allocations: make([]AllocationResult, len(weights))}
+
+	for i, strategy := range p.strategies {
+		result.Allocations[i] = AllocationResult{
+			Strategy:   strategy.Name,
+			Allocation: weights[i] * p.totalAssets,
+			Weight:     weights[i],
+		}
+		if !p.trades(weights, i) {
+			continue
+		}
+
+		delta := weights[i] - p.cost.anchor[i]
+		result.Legs++
+		result.Turnover += math.Abs(delta)
+		result.ExpectedGain += delta * strategy.ExpectedReturn * p.horizon * p.totalAssets
+		if delta > 0 {
+			result.TradingCost += delta * strategy.EntryCost * p.totalAssets
+		} else {
+			result.TradingCost -= delta * strategy.ExitCost * p.totalAssets
+		}
+		result.TradingCost += strategy.FixedCost
+	}
+
+	result.NetBenefit = result.ExpectedGain - result.TradingCost
+	return result
+}
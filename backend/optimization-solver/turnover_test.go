@@ -0,0 +1,141 @@
+package solver
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// held is a strategy with a current allocation and proportional trading costs
+func held(name string, expectedReturn, volatility, current, tradeCost float64) StrategyInput {
+	s := strategy(name, expectedReturn, volatility)
+	s.CurrentAlloc, s.EntryCost, s.ExitCost = current, tradeCost, tradeCost
+	return s
+}
+
+func TestOptimizeWithTurnover(t *testing.T) {
+	year := 365 * 24 * time.Hour
+
+	withFixedCost := func(s StrategyInput, fixedCost float64) StrategyInput {
+		s.FixedCost = fixedCost
+		return s
+	}
+
+	tests := []struct {
+		name       string
+		strategies []StrategyInput
+		config     TurnoverConfig
+		want       []float64
+		legs       int
+	}{
+		{
+			// μᵢ − λσᵢ²wᵢ is equal across strategies at 0.6 / 0.4
+			name:       "zero costs reduce to mean-variance",
+			strategies: []StrategyInput{held("a", 0.10, 0.2, 0.5, 0), held("b", 0.06, 0.1, 0.5, 0)},
+			config:     TurnoverConfig{Horizon: year, RiskAversion: 2},
+			want:       []float64{0.6, 0.4},
+			legs:       2,
+		},
+		{
+			name:       "zero costs and risk aversion maximize return",
+			strategies: []StrategyInput{held("a", 0.10, 0.2, 0.5, 0), held("b", 0.06, 0.1, 0.5, 0)},
+			config:     TurnoverConfig{Horizon: year},
+			want:       []float64{1, 0},
+			legs:       2,
+		},
+		{
+			// c's optimum is 0.402, a 2 unit move against a 50 unit minimum
+			name: "leg below minimum trade size",
+			strategies: []StrategyInput{
+				held("a", 0.09, 0.2, 0.3, 0),
+				held("b", 0.05196, 0.1, 0.3, 0),
+				held("c", 0.05804, 0.1, 0.4, 0),
+			},
+			config: TurnoverConfig{Horizon: year, RiskAversion: 2, MinTradeSize: 50},
+			want:   []float64{0.50004, 0.09996, 0.4},
+			legs:   2,
+		},
+		{
+			// A month of a 5% spread does not pay for 10% round trip costs
+			name:       "costs outweigh the gain",
+			strategies: []StrategyInput{held("a", 0.10, 0.01, 0.5, 0.05), held("b", 0.05, 0.01, 0.5, 0.05)},
+			config:     TurnoverConfig{Horizon: 30 * 24 * time.Hour},
+			want:       []float64{0.5, 0.5},
+		},
+		{
+			// Moving everything to c gains 10 and costs 1 in exit fees plus its fixed cost
+			name: "fixed cost leg dropped",
+			strategies: []StrategyInput{
+				held("a", 0.05, 0.01, 0.5, 0.001),
+				held("b", 0.05, 0.01, 0.5, 0.001),
+				withFixedCost(held("c", 0.06, 0.01, 0, 0), 20),
+			},
+			config: TurnoverConfig{Horizon: year},
+			want:   []float64{0.5, 0.5, 0},
+		},
+		{
+			name: "fixed cost leg kept",
+			strategies: []StrategyInput{
+				held("a", 0.05, 0.01, 0.5, 0.001),
+				held("b", 0.05, 0.01, 0.5, 0.001),
+				withFixedCost(held("c", 0.06, 0.01, 0, 0), 5),
+			},
+			config: TurnoverConfig{Horizon: year},
+			want:   []float64{0, 0, 1},
+			legs:   3,
+		},
+		{
+			// No gain in return, but the leg takes a variance penalty of about 250 off
+			// the portfolio, far more than its fixed cost
+			name: "fixed cost leg kept for its risk reduction",
+			strategies: []StrategyInput{
+				held("a", 0.05, 0.5, 1, 0),
+				withFixedCost(held("b", 0.05, 0.01, 0, 0), 1),
+			},
+			config: TurnoverConfig{Horizon: year, RiskAversion: 2},
+			want:   []float64{0.0004, 0.9996},
+			legs:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := unconstrainedSolver(t).OptimizeWithTurnover(tt.strategies, 1000, tt.config)
+			if err != nil {
+				t.Fatalf("OptimizeWithTurnover: %v", err)
+			}
+
+			for i, want := range tt.want {
+				if got := result.Allocations[i].Weight; math.Abs(got-want) > 1e-3 {
+					t.Errorf("weights = %v, want %v", weightsOf(result.Allocations), tt.want)
+					break
+				}
+			}
+			if result.Legs != tt.legs {
+				t.Errorf("legs = %d, want %d", result.Legs, tt.legs)
+			}
+
+			for i, s := range tt.strategies {
+				moved := math.Abs(result.Allocations[i].Weight - s.CurrentAlloc)
+				if moved != 0 && moved*1000 < tt.config.MinTradeSize {
+					t.Errorf("%s moved %v, below the minimum trade size", s.Name, moved*1000)
+				}
+			}
+
+			if result.Legs == 0 && (result.NetBenefit != 0 || result.TradingCost != 0) {
+				t.Errorf("no trade reports net benefit %v and cost %v", result.NetBenefit, result.TradingCost)
+			}
+			if math.Abs(result.NetBenefit-(result.ExpectedGain-result.TradingCost)) > 1e-9 {
+				t.Errorf("net benefit %v is not gain %v less cost %v", result.NetBenefit, result.ExpectedGain, result.TradingCost)
+			}
+		})
+	}
+}
+
+func weightsOf(allocations []AllocationResult) []float64 {
+	weights := make([]float64, len(allocations))
+	for i, allocation := range allocations {
+		weights[i] = allocation.Weight
+	}
+	return weights
+}
@@ -1,56 +1,340 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
 	"os"
+	"path/filepath"
+	"reflect"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/joho/godotenv"
+	"github.com/pelletier/go-toml/v2"
 )
 
-// Config holds application configuration
+// Config holds application configuration.
+//
+// Values are layered with increasing precedence: field defaults, the config file
+// named by CONFIG_FILE (YAML or TOML), the .env file, then process environment.
+// Each field is read from the `env` variable or the `file` key of its tags.
 type Config struct {
 	// Blockchain
-	BaseRPCURL      string
-	BaseChainID     int64
-	KeeperPrivateKey string
-	
+	BaseRPCURLs []string `env:"BASE_RPC_URL" file:"base_rpc_url" default:"https://mainnet.base.org"` // Comma-separated, in order of preference
+	BaseChainID int64    `env:"BASE_CHAIN_ID" file:"base_chain_id" default:"8453"`
+
+	// Keeper signer
+	KeeperSigner               string `env:"KEEPER_SIGNER" file:"keeper_signer" default:"raw"` // raw, keystore or remote
+	KeeperPrivateKey           string `env:"KEEPER_PRIVATE_KEY" file:"keeper_private_key"`
+	KeeperKeystoreFile         string `env:"KEEPER_KEYSTORE_FILE" file:"keeper_keystore_file"`
+	KeeperKeystorePasswordFile string `env:"KEEPER_KEYSTORE_PASSWORD_FILE" file:"keeper_keystore_password_file"`
+	KeeperRemoteSignerURL      string `env:"KEEPER_REMOTE_SIGNER_URL" file:"keeper_remote_signer_url"`
+	KeeperAddress              string `env:"KEEPER_ADDRESS" file:"keeper_address"`
+
+	// RPC failover
+	RPCQuorum              int           `env:"RPC_QUORUM" file:"rpc_quorum" default:"1"`
+	RPCMaxBlockLag         uint64        `env:"RPC_MAX_BLOCK_LAG" file:"rpc_max_block_lag" default:"5"`
+	RPCMaxLatency          time.Duration `env:"RPC_MAX_LATENCY" file:"rpc_max_latency" default:"2s"`
+	RPCRequestTimeout      time.Duration `env:"RPC_REQUEST_TIMEOUT" file:"rpc_request_timeout" default:"10s"`
+	RPCHealthCheckInterval time.Duration `env:"RPC_HEALTH_CHECK_INTERVAL" file:"rpc_health_check_interval" default:"15s"`
+
 	// Contracts
-	VaultAddress      string
-	ControllerAddress string
-	
+	VaultAddress            string `env:"AEGIS_VAULT_ADDRESS" file:"vault_address"`
+	ControllerAddress       string `env:"AEGIS_CONTROLLER_ADDRESS" file:"controller_address"`
+	DeploymentArtifactsPath string `env:"DEPLOYMENT_ARTIFACTS_PATH" file:"deployment_artifacts_path" default:"./deployments/base-deployment.json"`
+
 	// Keeper
-	RebalanceInterval int
-	GasPriceMultiplier float64
-	
+	RebalanceInterval     time.Duration `env:"REBALANCE_INTERVAL" file:"rebalance_interval" default:"1h"`
+	RiskTolerance         float64       `env:"RISK_TOLERANCE" file:"risk_tolerance" default:"0.5"`
+	SolverMode            string        `env:"SOLVER_MODE" file:"solver_mode" default:"score"`
+	SolverConstraintsFile string        `env:"SOLVER_CONSTRAINTS_FILE" file:"solver_constraints_file"` // YAML or JSON constraint set
+	SolverCorrelationFile string        `env:"SOLVER_CORRELATION_FILE" file:"solver_correlation_file"` // YAML or JSON strategy return correlations
+	SolverReturnModel     string        `env:"SOLVER_RETURN_MODEL" file:"solver_return_model" default:"predicted"`
+	SolverRiskFreeRate    float64       `env:"SOLVER_RISK_FREE_RATE" file:"solver_risk_free_rate" default:"0"`
+	SolverTargetReturn    float64       `env:"SOLVER_TARGET_RETURN" file:"solver_target_return" default:"0"` // Expected return floor for min_variance
+	TurnoverRiskAversion  float64       `env:"TURNOVER_RISK_AVERSION" file:"turnover_risk_aversion" default:"2"`
+	TurnoverEntryCostBps  float64       `env:"TURNOVER_ENTRY_COST_BPS" file:"turnover_entry_cost_bps" default:"5"`
+	TurnoverExitCostBps   float64       `env:"TURNOVER_EXIT_COST_BPS" file:"turnover_exit_cost_bps" default:"5"`
+	TurnoverLegCost       float64       `env:"TURNOVER_LEG_COST" file:"turnover_leg_cost" default:"0.5"`   // Asset units per strategy touched
+	TurnoverMinTrade      float64       `env:"TURNOVER_MIN_TRADE" file:"turnover_min_trade" default:"100"` // Asset units
+	MinNetBenefit         float64       `env:"REBALANCE_MIN_NET_BENEFIT" file:"rebalance_min_net_benefit" default:"0"`
+	DriftThreshold        float64       `env:"REBALANCE_DRIFT_THRESHOLD" file:"rebalance_drift_threshold" default:"0.05"`
+	DriftThresholdUnits   string        `env:"REBALANCE_DRIFT_ABSOLUTE_THRESHOLD" file:"rebalance_drift_absolute_threshold" default:"0"` // Asset base units, 0 disables
+	TurnoverThreshold     float64       `env:"REBALANCE_TURNOVER_THRESHOLD" file:"rebalance_turnover_threshold" default:"0.10"`
+	MinGainToCost         float64       `env:"REBALANCE_MIN_GAIN_TO_COST" file:"rebalance_min_gain_to_cost" default:"1.0"`
+	ETHPriceUSD           float64       `env:"ETH_PRICE_USD" file:"eth_price_usd" default:"2000"`
+	TxConfirmations       uint64        `env:"TX_CONFIRMATIONS" file:"tx_confirmations" default:"3"`
+	TxConfirmationTimeout time.Duration `env:"TX_CONFIRMATION_TIMEOUT" file:"tx_confirmation_timeout" default:"5m"`
+	StuckTxTimeout        time.Duration `env:"STUCK_TX_TIMEOUT" file:"stuck_tx_timeout" default:"3m"`
+	GasPriceMultiplier    float64       `env:"GAS_PRICE_MULTIPLIER" file:"gas_price_multiplier" default:"1.1"`
+
+	// Fees (EIP-1559)
+	FeeStrategy           string  `env:"FEE_STRATEGY" file:"fee_strategy" default:"multiplier"`
+	PriorityFeeGwei       float64 `env:"PRIORITY_FEE_GWEI" file:"priority_fee_gwei" default:"0.001"`
+	PriorityFeePercentile float64 `env:"PRIORITY_FEE_PERCENTILE" file:"priority_fee_percentile" default:"50"`
+	FixedMaxFeeGwei       float64 `env:"FIXED_MAX_FEE_GWEI" file:"fixed_max_fee_gwei" default:"0"`
+	MaxFeeCeilingGwei     float64 `env:"MAX_FEE_CEILING_GWEI" file:"max_fee_ceiling_gwei" default:"0"`
+
+	// Rebalance history
+	HistoryDBPath string `env:"HISTORY_DB_PATH" file:"history_db_path" default:"./data/aegis-history.db"` // Written by the keeper, read by the API
+
+	// Event indexer
+	IndexerDBPath        string        `env:"INDEXER_DB_PATH" file:"indexer_db_path" default:"./data/aegis-events.db"`
+	IndexerStartBlock    uint64        `env:"INDEXER_START_BLOCK" file:"indexer_start_block" default:"0"` // Deployment block of the controller and vault
+	IndexerChunkSize     uint64        `env:"INDEXER_CHUNK_SIZE" file:"indexer_chunk_size" default:"2000"`
+	IndexerConfirmations uint64        `env:"INDEXER_CONFIRMATIONS" file:"indexer_confirmations" default:"2"`
+	IndexerPollInterval  time.Duration `env:"INDEXER_POLL_INTERVAL" file:"indexer_poll_interval" default:"2s"`
+	IndexerReorgDepth    uint64        `env:"INDEXER_REORG_DEPTH" file:"indexer_reorg_depth" default:"64"`
+
+	// Oracle & data feeds
+	ChainlinkETHUSDFeed          string        `env:"CHAINLINK_ETH_USD_FEED" file:"chainlink_eth_usd_feed"` // ETH_PRICE_USD is used when unset
+	ChainlinkETHUSDHeartbeat     time.Duration `env:"CHAINLINK_ETH_USD_HEARTBEAT" file:"chainlink_eth_usd_heartbeat" default:"25m"`
+	ChainlinkUSDCUSDFeed         string        `env:"CHAINLINK_USDC_USD_FEED" file:"chainlink_usdc_usd_feed"`
+	ChainlinkUSDCUSDHeartbeat    time.Duration `env:"CHAINLINK_USDC_USD_HEARTBEAT" file:"chainlink_usdc_usd_heartbeat" default:"25h"`
+	ChainlinkMaxDeviationPercent float64       `env:"CHAINLINK_MAX_DEVIATION_PERCENT" file:"chainlink_max_deviation_percent" default:"10"`
+	SequencerUptimeFeed          string        `env:"CHAINLINK_SEQUENCER_UPTIME_FEED" file:"chainlink_sequencer_uptime_feed"`
+	SequencerGracePeriod         time.Duration `env:"SEQUENCER_GRACE_PERIOD" file:"sequencer_grace_period" default:"1h"`
+	AggregatorSourceTimeout      time.Duration `env:"AGGREGATOR_SOURCE_TIMEOUT" file:"aggregator_source_timeout" default:"5s"`
+
 	// ML Engine
-	MLAPIUrl string
-	
+	MLAPIUrl             string `env:"ML_API_URL" file:"ml_api_url" default:"http://localhost:5000"`
+	PredictionWindowDays int    `env:"PREDICTION_WINDOW_DAYS" file:"prediction_window_days" default:"7"`
+
 	// API
-	APIPort string
-	APIHost string
-}
-
-// LoadConfig loads configuration from environment variables
-func LoadConfig() *Config {
-	rebalanceInterval, _ := strconv.Atoi(getEnv("REBALANCE_INTERVAL_SECONDS", "3600"))
-	gasPriceMultiplier, _ := strconv.ParseFloat(getEnv("GAS_PRICE_MULTIPLIER", "1.1"), 64)
-	
-	return &Config{
-		BaseRPCURL:         getEnv("BASE_RPC_URL", "https://mainnet.base.org"),
-		BaseChainID:        8453,
-		KeeperPrivateKey:   getEnv("KEEPER_PRIVATE_KEY", ""),
-		VaultAddress:       getEnv("AEGIS_VAULT_ADDRESS", ""),
-		ControllerAddress:  getEnv("AEGIS_CONTROLLER_ADDRESS", ""),
-		RebalanceInterval:  rebalanceInterval,
-		GasPriceMultiplier: gasPriceMultiplier,
-		MLAPIUrl:           getEnv("ML_API_URL", "http://localhost:5000"),
-		APIPort:            getEnv("API_PORT", "8080"),
-		APIHost:            getEnv("API_HOST", "0.0.0.0"),
-	}
-}
-
-func getEnv(key, defaultValue string) string {
-	value := os.Getenv(key)
-	if value == "" {
-		return defaultValue
-	}
-	return value
+	APIPort     string        `env:"API_PORT" file:"api_port" default:"8080"`
+	APIHost     string        `env:"API_HOST" file:"api_host" default:"0.0.0.0"`
+	APICacheTTL time.Duration `env:"API_CACHE_TTL" file:"api_cache_ttl" default:"10s"` // How long chain reads are served from cache
+
+	// General
+	Environment string `env:"ENVIRONMENT" file:"environment" default:"development"`
+	LogLevel    string `env:"LOG_LEVEL" file:"log_level" default:"info"`
+}
+
+// removedVariables are environment variables that are no longer read, with their replacement
+var removedVariables = map[string]string{
+	"REBALANCE_INTERVAL_SECONDS": "REBALANCE_INTERVAL (a duration such as 1h or 30m)",
+}
+
+// ValidationError aggregates every configuration problem found at startup
+type ValidationError struct {
+	Errors []error
+}
+
+func (e *ValidationError) Error() string {
+	lines := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		lines[i] = "  - " + err.Error()
+	}
+	return fmt.Sprintf("invalid configuration (%d problems):\n%s", len(e.Errors), strings.Join(lines, "\n"))
+}
+
+// Unwrap returns the individual problems
+func (e *ValidationError) Unwrap() []error {
+	return e.Errors
+}
+
+// source is one configuration layer
+type source struct {
+	name   string
+	values map[string]string
+	// byFileKey selects whether values are keyed by the `file` tag instead of `env`
+	byFileKey bool
+}
+
+// LoadConfig loads configuration from defaults, CONFIG_FILE, .env and the environment,
+// and validates it. All problems are reported together in a ValidationError.
+func LoadConfig() (*Config, error) {
+	return Load(os.Getenv("CONFIG_FILE"), ".env")
+}
+
+// Load loads configuration from the given config file and dotenv file. Either path may
+// be empty; a missing .env file is not an error, a missing config file is.
+func Load(configFile, dotenvFile string) (*Config, error) {
+	var problems []error
+
+	// Lowest to highest precedence
+	var sources []source
+
+	if configFile != "" {
+		values, err := readConfigFile(configFile)
+		if err != nil {
+			return nil, &ValidationError{Errors: []error{err}}
+		}
+		sources = append(sources, source{name: configFile, values: values, byFileKey: true})
+	}
+
+	if dotenvFile != "" {
+		values, err := godotenv.Read(dotenvFile)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, &ValidationError{Errors: []error{fmt.Errorf("%s: %w", dotenvFile, err)}}
+		}
+		sources = append(sources, source{name: dotenvFile, values: values})
+	}
+
+	sources = append(sources, source{name: "environment", values: environ()})
+
+	for _, src := range sources {
+		for name, replacement := range removedVariables {
+			if _, ok := src.values[name]; ok && !src.byFileKey {
+				problems = append(problems, fmt.Errorf("%s (%s) is no longer supported, use %s", name, src.name, replacement))
+			}
+		}
+	}
+
+	cfg := &Config{}
+	value := reflect.ValueOf(cfg).Elem()
+	typ := value.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		envKey := field.Tag.Get("env")
+
+		raw, origin := field.Tag.Get("default"), "default"
+		for _, src := range sources {
+			key := envKey
+			if src.byFileKey {
+				key = field.Tag.Get("file")
+			}
+			if v, ok := src.values[key]; ok && v != "" {
+				raw, origin = v, src.name
+			}
+		}
+
+		if raw == "" {
+			continue
+		}
+		if err := setField(value.Field(i), raw); err != nil {
+			problems = append(problems, fmt.Errorf("%s (%s): %w", envKey, origin, err))
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, &ValidationError{Errors: problems}
+	}
+
+	// go-ethereum expects the key without a 0x prefix
+	cfg.KeeperPrivateKey = strings.TrimPrefix(cfg.KeeperPrivateKey, "0x")
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// readConfigFile decodes a YAML or TOML file into flat string values keyed by `file` tag
+func readConfigFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	decoded := map[string]interface{}{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &decoded)
+	case ".toml":
+		err = toml.Unmarshal(data, &decoded)
+	default:
+		return nil, fmt.Errorf("unsupported config file type %q (expected .yaml, .yml or .toml)", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	values := make(map[string]string, len(decoded))
+	for key, v := range decoded {
+		switch v := v.(type) {
+		case []interface{}:
+			parts := make([]string, len(v))
+			for i, part := range v {
+				parts[i] = fmt.Sprint(part)
+			}
+			values[key] = strings.Join(parts, ",")
+		case nil:
+		default:
+			values[key] = fmt.Sprint(v)
+		}
+	}
+
+	return values, nil
+}
+
+// environ returns the process environment as a map
+func environ() map[string]string {
+	values := make(map[string]string)
+	for _, entry := range os.Environ() {
+		if key, value, ok := strings.Cut(entry, "="); ok {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// setField parses raw into a config field according to its type
+func setField(field reflect.Value, raw string) error {
+	raw = strings.TrimSpace(raw)
+
+	if field.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", raw)
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		field.SetInt(n)
+	case reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid unsigned integer %q", raw)
+		}
+		field.SetUint(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", raw)
+		}
+		field.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		field.SetBool(b)
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
+
+// DriftThresholdAmount returns REBALANCE_DRIFT_ABSOLUTE_THRESHOLD in asset base units,
+// or nil when it is not an integer
+func (c *Config) DriftThresholdAmount() *big.Int {
+	threshold, ok := new(big.Int).SetString(c.DriftThresholdUnits, 10)
+	if !ok {
+		return nil
+	}
+	return threshold
 }
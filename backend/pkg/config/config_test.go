@@ -0,0 +1,256 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// clearEnv keeps the process environment from overriding the layers under test
+func clearEnv(t *testing.T, keys ...string) {
+	t.Helper()
+	for _, key := range keys {
+		t.Setenv(key, "")
+	}
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+// problems returns the messages of a flat ValidationError
+func problems(t *testing.T, err error) []string {
+	t.Helper()
+	var validation *ValidationError
+	if !errors.As(err, &validation) {
+		t.Fatalf("error = %v, want a ValidationError", err)
+	}
+	messages := make([]string, len(validation.Errors))
+	for i, problem := range validation.Errors {
+		if errors.As(problem, new(*ValidationError)) {
+			t.Errorf("problem %d is a nested ValidationError: %v", i, problem)
+		}
+		messages[i] = problem.Error()
+	}
+	return messages
+}
+
+// containsAll reports whether every want appears in some message, and the counts match
+func containsAll(messages []string, want ...string) bool {
+	if len(messages) != len(want) {
+		return false
+	}
+	for _, w := range want {
+		found := false
+		for _, message := range messages {
+			found = found || strings.Contains(message, w)
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestLoadLayering(t *testing.T) {
+	clearEnv(t, "BASE_RPC_URL", "LOG_LEVEL", "API_PORT", "RISK_TOLERANCE", "REBALANCE_INTERVAL", "SOLVER_MODE")
+
+	configFiles := map[string]string{
+		"config.yaml": `
+base_rpc_url:
+  - https://base.example.org
+  - https://backup.example.org
+log_level: warn
+api_port: 9000
+risk_tolerance: 0.3
+rebalance_interval: 2h
+solver_mode: hrp
+`,
+		"config.toml": `
+base_rpc_url = ["https://base.example.org", "https://backup.example.org"]
+log_level = "warn"
+api_port = 9000
+risk_tolerance = 0.3
+rebalance_interval = "2h"
+solver_mode = "hrp"
+`,
+	}
+	dotenv := writeFile(t, ".env", "API_PORT=9100\nRISK_TOLERANCE=0.4\nSOLVER_MODE=\n")
+
+	for name, content := range configFiles {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("RISK_TOLERANCE", "0.6")
+
+			cfg, err := Load(writeFile(t, name, content), dotenv)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+
+			// Defaults < CONFIG_FILE < .env < environment; empty values do not override
+			tests := []struct {
+				key  string
+				got  interface{}
+				want interface{}
+			}{
+				{"ENVIRONMENT (default)", cfg.Environment, "development"},
+				{"BASE_RPC_URL (config file)", cfg.BaseRPCURLs, []string{"https://base.example.org", "https://backup.example.org"}},
+				{"LOG_LEVEL (config file)", cfg.LogLevel, "warn"},
+				{"REBALANCE_INTERVAL (config file)", cfg.RebalanceInterval, 2 * time.Hour},
+				{"SOLVER_MODE (config file under an empty .env value)", cfg.SolverMode, "hrp"},
+				{"API_PORT (.env)", cfg.APIPort, "9100"},
+				{"RISK_TOLERANCE (environment)", cfg.RiskTolerance, 0.6},
+			}
+			for _, tt := range tests {
+				if !reflect.DeepEqual(tt.got, tt.want) {
+					t.Errorf("%s = %v, want %v", tt.key, tt.got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadWithoutFiles(t *testing.T) {
+	clearEnv(t, "API_PORT", "RISK_TOLERANCE")
+
+	cfg, err := Load("", filepath.Join(t.TempDir(), "missing.env"))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.APIPort != "8080" || cfg.RiskTolerance != 0.5 {
+		t.Errorf("API_PORT = %s, RISK_TOLERANCE = %v; want the defaults", cfg.APIPort, cfg.RiskTolerance)
+	}
+
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yaml"), ""); err == nil {
+		t.Error("Load succeeded with a missing config file")
+	}
+}
+
+func TestLoadAggregatesErrors(t *testing.T) {
+	t.Run("parse errors", func(t *testing.T) {
+		clearEnv(t, "RPC_QUORUM", "REBALANCE_INTERVAL", "REBALANCE_INTERVAL_SECONDS")
+		t.Setenv("REBALANCE_INTERVAL_SECONDS", "3600")
+
+		configFile := writeFile(t, "config.yaml", "rpc_quorum: many\n")
+		dotenv := writeFile(t, ".env", "REBALANCE_INTERVAL=hourly\n")
+
+		_, err := Load(configFile, dotenv)
+		messages := problems(t, err)
+		if !containsAll(messages,
+			"RPC_QUORUM ("+configFile+")",
+			"REBALANCE_INTERVAL ("+dotenv+")",
+			"REBALANCE_INTERVAL_SECONDS (environment) is no longer supported",
+		) {
+			t.Errorf("problems = %q, want the quorum, interval and removed variable", messages)
+		}
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		clearEnv(t, "LOG_LEVEL", "ENVIRONMENT", "API_PORT")
+		t.Setenv("LOG_LEVEL", "loud")
+		t.Setenv("ENVIRONMENT", "test")
+		t.Setenv("API_PORT", "80800")
+
+		_, err := Load("", "")
+		if messages := problems(t, err); !containsAll(messages, "LOG_LEVEL", "ENVIRONMENT", "API_PORT") {
+			t.Errorf("problems = %q, want LOG_LEVEL, ENVIRONMENT and API_PORT", messages)
+		}
+	})
+}
+
+// defaults returns the default configuration, which passes every validation
+func defaults(t *testing.T) *Config {
+	t.Helper()
+	clearEnv(t, "KEEPER_SIGNER", "KEEPER_PRIVATE_KEY", "KEEPER_KEYSTORE_FILE", "KEEPER_KEYSTORE_PASSWORD_FILE")
+	cfg, err := Load("", "")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return cfg
+}
+
+func TestServiceValidationAggregatesSharedProblems(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(*Config) error
+		breakCfg func(*Config)
+		want     []string
+	}{
+		{
+			name:     "keeper",
+			validate: (*Config).ValidateKeeper,
+			breakCfg: func(c *Config) {
+				c.KeeperKeystoreFile, c.KeeperKeystorePasswordFile = "", ""
+				c.SolverMode = "greedy"
+				c.DriftThresholdUnits = "-5"
+			},
+			want: []string{"KEEPER_KEYSTORE_FILE", "KEEPER_KEYSTORE_PASSWORD_FILE", "SOLVER_MODE", "REBALANCE_DRIFT_ABSOLUTE_THRESHOLD"},
+		},
+		{
+			name:     "api",
+			validate: (*Config).ValidateAPI,
+			breakCfg: func(c *Config) { c.APICacheTTL = 0 },
+			want:     []string{"API_CACHE_TTL"},
+		},
+		{
+			name:     "indexer",
+			validate: (*Config).ValidateIndexer,
+			breakCfg: func(c *Config) {
+				c.IndexerChunkSize = 0
+				c.IndexerDBPath = ""
+			},
+			want: []string{"INDEXER_CHUNK_SIZE", "INDEXER_DB_PATH"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := defaults(t)
+			cfg.KeeperSigner = "keystore"
+			cfg.KeeperKeystoreFile = "keeper.json"
+			cfg.KeeperKeystorePasswordFile = "password"
+			if err := tt.validate(cfg); err != nil {
+				t.Fatalf("defaults invalid: %v", err)
+			}
+
+			// Problems shared by every service are reported with the service's own
+			cfg.LogLevel = "loud"
+			cfg.BaseRPCURLs = []string{"ftp://base.example.org"}
+			tt.breakCfg(cfg)
+
+			messages := problems(t, tt.validate(cfg))
+			want := append([]string{"LOG_LEVEL", "BASE_RPC_URL"}, tt.want...)
+			if !containsAll(messages, want...) {
+				t.Errorf("problems = %q, want %v", messages, want)
+			}
+		})
+	}
+}
+
+func TestDriftThresholdAmount(t *testing.T) {
+	tests := []struct {
+		units string
+		want  string
+	}{
+		{"0", "0"},
+		{"2500000000", "2500000000"},
+		{"1000000000000000000000000", "1000000000000000000000000"},
+		{"1.5", "<nil>"},
+		{"", "<nil>"},
+	}
+
+	for _, tt := range tests {
+		cfg := &Config{DriftThresholdUnits: tt.units}
+		if got := cfg.DriftThresholdAmount().String(); got != tt.want {
+			t.Errorf("DriftThresholdAmount(%q) = %s, want %s", tt.units, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-yaml"
+)
+
+// Correlations are pairwise correlations of strategy returns, keyed by strategy address
+type Correlations map[common.Address]map[common.Address]float64
+
+// correlationFile is the layout of SOLVER_CORRELATION_FILE:
+//
+//	correlations:
+//	  "0xStrategyA":
+//	    "0xStrategyB": 0.6
+//	    "0xStrategyC": 0.1
+//
+// Each pair is listed once, under either strategy; a pair listed under both must agree.
+type correlationFile struct {
+	Correlations map[string]map[string]float64 `json:"correlations" yaml:"correlations"`
+}
+
+// LoadCorrelations reads a YAML or JSON correlation file. Addresses must be valid and
+// correlations between -1 and 1.
+func LoadCorrelations(path string) (Correlations, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read correlation file: %w", err)
+	}
+
+	var decoded correlationFile
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalWithOptions(data, &decoded, yaml.Strict())
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&decoded)
+	default:
+		return nil, fmt.Errorf("unsupported correlation file type %q (expected .yaml, .yml or .json)", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse correlation file %s: %w", path, err)
+	}
+	if len(decoded.Correlations) == 0 {
+		return nil, fmt.Errorf("correlation file %s lists no correlations", path)
+	}
+
+	correlations := make(Correlations)
+	for rawA, row := range decoded.Correlations {
+		for rawB, value := range row {
+			if !common.IsHexAddress(rawA) || !common.IsHexAddress(rawB) {
+				return nil, fmt.Errorf("correlation %s/%s: strategies must be 0x-prefixed 20 byte hex addresses", rawA, rawB)
+			}
+			a, b := common.HexToAddress(rawA), common.HexToAddress(rawB)
+			if math.IsNaN(value) || value < -1 || value > 1 {
+				return nil, fmt.Errorf("correlation %s/%s must be between -1 and 1, got %v", a.Hex(), b.Hex(), value)
+			}
+			if a == b {
+				if value != 1 {
+					return nil, fmt.Errorf("correlation of %s with itself must be 1, got %v", a.Hex(), value)
+				}
+				continue
+			}
+			if existing, ok := correlations.lookup(a, b); ok && existing != value {
+				return nil, fmt.Errorf("correlation %s/%s is listed as both %v and %v", a.Hex(), b.Hex(), existing, value)
+			}
+			correlations.set(a, b, value)
+			correlations.set(b, a, value)
+		}
+	}
+
+	return correlations, nil
+}
+
+// Matrix returns the correlation matrix of the strategies, in order. Every pair must
+// be listed, and the matrix must be positive semi-definite.
+func (c Correlations) Matrix(strategies []common.Address) ([][]float64, error) {
+	n := len(strategies)
+	matrix := make([][]float64, n)
+	for i := range matrix {
+		matrix[i] = make([]float64, n)
+		matrix[i][i] = 1
+	}
+
+	var missing []string
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			value, ok := c.lookup(strategies[i], strategies[j])
+			if !ok {
+				missing = append(missing, strategies[i].Hex()+"/"+strategies[j].Hex())
+				continue
+			}
+			matrix[i][j], matrix[j][i] = value, value
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("no correlation configured for %s", strings.Join(missing, ", "))
+	}
+
+	if !positiveSemiDefinite(matrix) {
+		return nil, fmt.Errorf("configured correlations of %d strategies are not a valid correlation matrix (not positive semi-definite)", n)
+	}
+	return matrix, nil
+}
+
+func (c Correlations) lookup(a, b common.Address) (float64, bool) {
+	value, ok := c[a][b]
+	return value, ok
+}
+
+func (c Correlations) set(a, b common.Address, value float64) {
+	if c[a] == nil {
+		c[a] = make(map[common.Address]float64)
+	}
+	c[a][b] = value
+}
+
+// positiveSemiDefinite attempts a Cholesky factorization, allowing for rounding in
+// the configured values
+func positiveSemiDefinite(m [][]float64) bool {
+	const tolerance = 1e-9
+	n := len(m)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+	}
+	for j := 0; j < n; j++ {
+		diagonal := m[j][j]
+		for k := 0; k < j; k++ {
+			diagonal -= l[j][k] * l[j][k]
+		}
+		if diagonal < -tolerance {
+			return false
+		}
+		if diagonal <= tolerance {
+			// A zero pivot needs a zero column below it
+			for i := j + 1; i < n; i++ {
+				sum := m[i][j]
+				for k := 0; k < j; k++ {
+					sum -= l[i][k] * l[j][k]
+				}
+				if math.Abs(sum) > 1e-6 {
+					return false
+				}
+			}
+			continue
+		}
+		l[j][j] = math.Sqrt(diagonal)
+		for i := j + 1; i < n; i++ {
+			sum := m[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k]
+			}
+			l[i][j] = sum / l[j][j]
+		}
+	}
+	return true
+}
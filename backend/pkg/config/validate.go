@@ -0,0 +1,255 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sirupsen/logrus"
+)
+
+// priorityFeePercentiles are the eth_feeHistory percentiles the percentile fee strategy supports
+var priorityFeePercentiles = []float64{10, 25, 50, 75, 90}
+
+// Validate checks settings shared by every service
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	if len(c.BaseRPCURLs) == 0 {
+		v.fail("BASE_RPC_URL must list at least one RPC endpoint")
+	}
+	for _, rpcURL := range c.BaseRPCURLs {
+		v.url("BASE_RPC_URL", rpcURL, "http", "https", "ws", "wss")
+	}
+	v.check(c.RPCQuorum >= 1 && c.RPCQuorum <= len(c.BaseRPCURLs), "RPC_QUORUM must be between 1 and the number of BASE_RPC_URL endpoints (%d), got %d", len(c.BaseRPCURLs), c.RPCQuorum)
+	v.positiveDuration("RPC_MAX_LATENCY", c.RPCMaxLatency)
+	v.positiveDuration("RPC_REQUEST_TIMEOUT", c.RPCRequestTimeout)
+	v.positiveDuration("RPC_HEALTH_CHECK_INTERVAL", c.RPCHealthCheckInterval)
+	v.url("ML_API_URL", c.MLAPIUrl, "http", "https")
+	v.check(c.BaseChainID > 0, "BASE_CHAIN_ID must be a positive integer, got %d", c.BaseChainID)
+	v.address("AEGIS_VAULT_ADDRESS", c.VaultAddress)
+	v.address("AEGIS_CONTROLLER_ADDRESS", c.ControllerAddress)
+	v.check(c.HistoryDBPath != "", "HISTORY_DB_PATH is required")
+	v.address("CHAINLINK_ETH_USD_FEED", c.ChainlinkETHUSDFeed)
+	v.address("CHAINLINK_USDC_USD_FEED", c.ChainlinkUSDCUSDFeed)
+	v.address("CHAINLINK_SEQUENCER_UPTIME_FEED", c.SequencerUptimeFeed)
+	v.positiveDuration("CHAINLINK_ETH_USD_HEARTBEAT", c.ChainlinkETHUSDHeartbeat)
+	v.positiveDuration("CHAINLINK_USDC_USD_HEARTBEAT", c.ChainlinkUSDCUSDHeartbeat)
+	v.check(c.ChainlinkMaxDeviationPercent >= 0 && c.ChainlinkMaxDeviationPercent < 100, "CHAINLINK_MAX_DEVIATION_PERCENT must be between 0 and 100, got %v", c.ChainlinkMaxDeviationPercent)
+	v.check(c.SequencerGracePeriod >= 0, "SEQUENCER_GRACE_PERIOD must not be negative, got %s", c.SequencerGracePeriod)
+	v.positiveDuration("AGGREGATOR_SOURCE_TIMEOUT", c.AggregatorSourceTimeout)
+
+	if port, err := strconv.Atoi(c.APIPort); err != nil || port <= 0 || port > 65535 {
+		v.fail("API_PORT must be a port number between 1 and 65535, got %q", c.APIPort)
+	}
+	switch c.Environment {
+	case "development", "staging", "production":
+	default:
+		v.fail("ENVIRONMENT %q is not supported (expected development, staging or production)", c.Environment)
+	}
+	if _, err := logrus.ParseLevel(c.LogLevel); err != nil {
+		v.fail("LOG_LEVEL %q is not a valid level (trace, debug, info, warn, error)", c.LogLevel)
+	}
+
+	return v.err()
+}
+
+// ValidateKeeper checks the settings the keeper bot needs in addition to Validate
+func (c *Config) ValidateKeeper() error {
+	v := &validator{}
+	v.include(c.Validate())
+
+	switch c.KeeperSigner {
+	case "raw":
+		if c.KeeperPrivateKey == "" {
+			v.fail("KEEPER_PRIVATE_KEY is required for the raw signer")
+		} else if _, err := crypto.HexToECDSA(c.KeeperPrivateKey); err != nil {
+			// Never echo the key itself
+			v.fail("KEEPER_PRIVATE_KEY is not a valid secp256k1 private key")
+		}
+		v.check(c.Environment != "production", "KEEPER_SIGNER=raw is not allowed when ENVIRONMENT=production, use keystore or remote")
+	case "keystore":
+		v.check(c.KeeperKeystoreFile != "", "KEEPER_KEYSTORE_FILE is required for the keystore signer")
+		v.check(c.KeeperKeystorePasswordFile != "", "KEEPER_KEYSTORE_PASSWORD_FILE is required for the keystore signer")
+	case "remote":
+		v.url("KEEPER_REMOTE_SIGNER_URL", c.KeeperRemoteSignerURL, "http", "https", "ws", "wss")
+		if c.KeeperAddress == "" {
+			v.fail("KEEPER_ADDRESS is required for the remote signer")
+		}
+	default:
+		v.fail("KEEPER_SIGNER %q is not supported (expected raw, keystore or remote)", c.KeeperSigner)
+	}
+	if c.KeeperSigner != "raw" && c.KeeperPrivateKey != "" {
+		v.fail("KEEPER_PRIVATE_KEY must not be set when KEEPER_SIGNER is %s", c.KeeperSigner)
+	}
+	v.address("KEEPER_ADDRESS", c.KeeperAddress)
+	v.check(c.DeploymentArtifactsPath != "", "DEPLOYMENT_ARTIFACTS_PATH is required")
+
+	v.positiveDuration("REBALANCE_INTERVAL", c.RebalanceInterval)
+	v.positiveDuration("TX_CONFIRMATION_TIMEOUT", c.TxConfirmationTimeout)
+	v.positiveDuration("STUCK_TX_TIMEOUT", c.StuckTxTimeout)
+	v.check(c.TxConfirmations > 0, "TX_CONFIRMATIONS must be at least 1")
+	v.check(c.PredictionWindowDays > 0, "PREDICTION_WINDOW_DAYS must be a positive integer, got %d", c.PredictionWindowDays)
+
+	v.fraction("RISK_TOLERANCE", c.RiskTolerance)
+	switch c.SolverMode {
+	case "score", "max_sharpe", "min_variance", "inverse_volatility", "equal_risk_contribution", "hrp":
+	case "turnover":
+		v.check(c.TurnoverRiskAversion >= 0, "TURNOVER_RISK_AVERSION must not be negative, got %v", c.TurnoverRiskAversion)
+		v.check(c.TurnoverEntryCostBps >= 0 && c.TurnoverEntryCostBps < 10000, "TURNOVER_ENTRY_COST_BPS must be between 0 and 10000, got %v", c.TurnoverEntryCostBps)
+		v.check(c.TurnoverExitCostBps >= 0 && c.TurnoverExitCostBps < 10000, "TURNOVER_EXIT_COST_BPS must be between 0 and 10000, got %v", c.TurnoverExitCostBps)
+		v.check(c.TurnoverLegCost >= 0, "TURNOVER_LEG_COST must not be negative, got %v", c.TurnoverLegCost)
+		v.check(c.TurnoverMinTrade >= 0, "TURNOVER_MIN_TRADE must not be negative, got %v", c.TurnoverMinTrade)
+		v.check(c.MinNetBenefit >= 0, "REBALANCE_MIN_NET_BENEFIT must not be negative, got %v", c.MinNetBenefit)
+	default:
+		v.fail("SOLVER_MODE %q is not supported (expected score, max_sharpe, min_variance, inverse_volatility, equal_risk_contribution, hrp or turnover)", c.SolverMode)
+	}
+	switch c.SolverMode {
+	case "max_sharpe", "min_variance", "equal_risk_contribution", "hrp":
+		v.check(c.SolverCorrelationFile != "", "SOLVER_CORRELATION_FILE is required for SOLVER_MODE %s", c.SolverMode)
+	}
+	if c.SolverCorrelationFile != "" {
+		if _, err := LoadCorrelations(c.SolverCorrelationFile); err != nil {
+			v.fail("SOLVER_CORRELATION_FILE is invalid: %v", err)
+		}
+	}
+	switch c.SolverReturnModel {
+	case "predicted", "black_litterman", "worst_case":
+	default:
+		v.fail("SOLVER_RETURN_MODEL %q is not supported (expected predicted, black_litterman or worst_case)", c.SolverReturnModel)
+	}
+	v.check(c.SolverRiskFreeRate >= 0 && c.SolverRiskFreeRate < 1, "SOLVER_RISK_FREE_RATE must be a fraction between 0 and 1, got %v", c.SolverRiskFreeRate)
+	v.check(c.SolverTargetReturn >= 0 && c.SolverTargetReturn < 1, "SOLVER_TARGET_RETURN must be a fraction between 0 and 1, got %v", c.SolverTargetReturn)
+	v.fraction("REBALANCE_DRIFT_THRESHOLD", c.DriftThreshold)
+	if threshold := c.DriftThresholdAmount(); threshold == nil || threshold.Sign() < 0 {
+		v.fail("REBALANCE_DRIFT_ABSOLUTE_THRESHOLD must be a non-negative integer amount of asset base units, got %q", c.DriftThresholdUnits)
+	}
+	v.check(c.TurnoverThreshold >= 0 && c.TurnoverThreshold <= 2, "REBALANCE_TURNOVER_THRESHOLD must be between 0 and 2, got %v", c.TurnoverThreshold)
+	v.check(c.MinGainToCost >= 0, "REBALANCE_MIN_GAIN_TO_COST must not be negative, got %v", c.MinGainToCost)
+	v.check(c.ETHPriceUSD > 0, "ETH_PRICE_USD must be positive, got %v", c.ETHPriceUSD)
+
+	v.check(c.GasPriceMultiplier >= 1, "GAS_PRICE_MULTIPLIER must be at least 1, got %v", c.GasPriceMultiplier)
+	v.check(c.PriorityFeeGwei >= 0, "PRIORITY_FEE_GWEI must not be negative, got %v", c.PriorityFeeGwei)
+	v.check(c.MaxFeeCeilingGwei >= 0, "MAX_FEE_CEILING_GWEI must not be negative, got %v", c.MaxFeeCeilingGwei)
+	switch c.FeeStrategy {
+	case "multiplier":
+	case "percentile":
+		supported := false
+		for _, p := range priorityFeePercentiles {
+			supported = supported || p == c.PriorityFeePercentile
+		}
+		v.check(supported, "PRIORITY_FEE_PERCENTILE must be one of %v, got %v", priorityFeePercentiles, c.PriorityFeePercentile)
+	case "fixed":
+		if c.FixedMaxFeeGwei <= 0 {
+			v.fail("FIXED_MAX_FEE_GWEI is required for the fixed fee strategy")
+		} else if c.FixedMaxFeeGwei < c.PriorityFeeGwei {
+			v.fail("FIXED_MAX_FEE_GWEI must not be below PRIORITY_FEE_GWEI")
+		}
+	default:
+		v.fail("FEE_STRATEGY %q is not supported (expected multiplier, percentile or fixed)", c.FeeStrategy)
+	}
+	if c.MaxFeeCeilingGwei > 0 && c.FeeStrategy == "fixed" {
+		v.check(c.FixedMaxFeeGwei <= c.MaxFeeCeilingGwei, "FIXED_MAX_FEE_GWEI must not exceed MAX_FEE_CEILING_GWEI")
+	}
+
+	return v.err()
+}
+
+// ValidateAPI checks the settings the API service needs in addition to Validate
+func (c *Config) ValidateAPI() error {
+	v := &validator{}
+	v.include(c.Validate())
+
+	v.check(c.DeploymentArtifactsPath != "", "DEPLOYMENT_ARTIFACTS_PATH is required")
+	v.positiveDuration("API_CACHE_TTL", c.APICacheTTL)
+
+	return v.err()
+}
+
+// ValidateIndexer checks the settings the event indexer needs in addition to Validate
+func (c *Config) ValidateIndexer() error {
+	v := &validator{}
+	v.include(c.Validate())
+
+	v.check(c.DeploymentArtifactsPath != "", "DEPLOYMENT_ARTIFACTS_PATH is required")
+	v.check(c.IndexerDBPath != "", "INDEXER_DB_PATH is required")
+	v.check(c.IndexerChunkSize > 0, "INDEXER_CHUNK_SIZE must be at least 1")
+	v.check(c.IndexerReorgDepth > 0, "INDEXER_REORG_DEPTH must be at least 1")
+	v.positiveDuration("INDEXER_POLL_INTERVAL", c.IndexerPollInterval)
+
+	return v.err()
+}
+
+// validator collects validation problems
+type validator struct {
+	problems []error
+}
+
+func (v *validator) fail(format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Errorf(format, args...))
+}
+
+// include adds the problems of another validation, or err itself when it is not a
+// ValidationError
+func (v *validator) include(err error) {
+	var validation *ValidationError
+	switch {
+	case errors.As(err, &validation):
+		v.problems = append(v.problems, validation.Errors...)
+	case err != nil:
+		v.problems = append(v.problems, err)
+	}
+}
+
+func (v *validator) check(ok bool, format string, args ...interface{}) {
+	if !ok {
+		v.fail(format, args...)
+	}
+}
+
+func (v *validator) url(key, value string, schemes ...string) {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" {
+		v.fail("%s must be an absolute URL, got %q", key, value)
+		return
+	}
+	for _, scheme := range schemes {
+		if parsed.Scheme == scheme {
+			return
+		}
+	}
+	v.fail("%s must use one of the schemes %v, got %q", key, schemes, parsed.Scheme)
+}
+
+// address checks an optional contract address
+func (v *validator) address(key, value string) {
+	if value == "" {
+		return
+	}
+	if !common.IsHexAddress(value) {
+		v.fail("%s must be a 0x-prefixed 20 byte hex address, got %q", key, value)
+		return
+	}
+	if common.HexToAddress(value) == (common.Address{}) {
+		v.fail("%s must not be the zero address", key)
+	}
+}
+
+func (v *validator) positiveDuration(key string, value time.Duration) {
+	v.check(value > 0, "%s must be a positive duration, got %s", key, value)
+}
+
+func (v *validator) fraction(key string, value float64) {
+	v.check(value >= 0 && value <= 1, "%s must be between 0 and 1, got %v", key, value)
+}
+
+func (v *validator) err() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: v.problems}
+}
@@ -0,0 +1,265 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite" // Registers the pure Go "sqlite" driver
+)
+
+// Status is the outcome of a rebalance run
+type Status string
+
+const (
+	StatusSucceeded Status = "succeeded"
+	StatusSkipped   Status = "skipped"
+	StatusFailed    Status = "failed"
+)
+
+// ParseStatus validates a status name
+func ParseStatus(value string) (Status, error) {
+	switch status := Status(value); status {
+	case StatusSucceeded, StatusSkipped, StatusFailed:
+		return status, nil
+	default:
+		return "", fmt.Errorf("unknown status %q (expected succeeded, skipped or failed)", value)
+	}
+}
+
+// ErrNotFound is returned when no rebalance matches
+var ErrNotFound = errors.New("rebalance not found")
+
+// Allocation is a strategy's allocation before and after a rebalance
+type Allocation struct {
+	Strategy      string `json:"strategy"`
+	Name          string `json:"name,omitempty"`
+	CurrentAmount string `json:"current_amount"` // Asset base units
+	TargetAmount  string `json:"target_amount"`  // Asset base units
+}
+
+// Record is one keeper rebalance run
+type Record struct {
+	ID         int64     `json:"id"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Status     Status    `json:"status"`
+	Reason     string    `json:"reason,omitempty"` // Why the run was skipped or failed
+
+	// Inputs is the portfolio state and predictions the run was based on
+	Inputs json.RawMessage `json:"inputs,omitempty"`
+	// Decision is the policy evaluation, when the run got that far
+	Decision json.RawMessage `json:"decision,omitempty"`
+	Targets  []Allocation    `json:"targets,omitempty"`
+
+	TxHash      string `json:"tx_hash,omitempty"`
+	BlockNumber uint64 `json:"block_number,omitempty"`
+	GasUsed     uint64 `json:"gas_used,omitempty"`
+}
+
+// Query filters rebalance records. Zero values do not filter.
+type Query struct {
+	Statuses []Status
+	Since    time.Time
+	Until    time.Time
+	Limit    int
+	Offset   int
+}
+
+const schema = `
+CREATE TABLE IF NOT EXISTS rebalances (
+	id           INTEGER PRIMARY KEY AUTOINCREMENT,
+	started_at   INTEGER NOT NULL,
+	finished_at  INTEGER NOT NULL,
+	status       TEXT    NOT NULL,
+	reason       TEXT    NOT NULL DEFAULT '',
+	inputs       TEXT,
+	decision     TEXT,
+	targets      TEXT,
+	tx_hash      TEXT    NOT NULL DEFAULT '',
+	block_number INTEGER NOT NULL DEFAULT 0,
+	gas_used     INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS rebalances_by_time ON rebalances (started_at);
+CREATE INDEX IF NOT EXISTS rebalances_by_status ON rebalances (status, started_at);
+`
+
+// Store persists rebalance runs in SQLite. The keeper writes to it and the API
+// service reads from the same file.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens or creates the history database at path
+func Open(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history store: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create history schema: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Save inserts a record and sets its ID
+func (s *Store) Save(ctx context.Context, record *Record) error {
+	if _, err := ParseStatus(string(record.Status)); err != nil {
+		return err
+	}
+
+	var targets []byte
+	if len(record.Targets) > 0 {
+		var err error
+		if targets, err = json.Marshal(record.Targets); err != nil {
+			return fmt.Errorf("failed to encode targets: %w", err)
+		}
+	}
+
+	result, err := s.db.ExecContext(ctx,
+		`INSERT INTO rebalances (started_at, finished_at, status, reason, inputs, decision, targets, tx_hash, block_number, gas_used)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.StartedAt.UnixMilli(), record.FinishedAt.UnixMilli(), string(record.Status), record.Reason,
+		nullableJSON(record.Inputs), nullableJSON(record.Decision), nullableJSON(targets),
+		record.TxHash, record.BlockNumber, record.GasUsed,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save rebalance: %w", err)
+	}
+
+	if record.ID, err = result.LastInsertId(); err != nil {
+		return fmt.Errorf("failed to read rebalance id: %w", err)
+	}
+	return nil
+}
+
+// List returns the records matching the query, newest first, and the total number
+// of matching records ignoring Limit and Offset
+func (s *Store) List(ctx context.Context, query Query) ([]Record, int, error) {
+	where, args := query.where()
+
+	var total int
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM rebalances`+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count rebalances: %w", err)
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = -1 // SQLite: no limit
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, started_at, finished_at, status, reason, inputs, decision, targets, tx_hash, block_number, gas_used
+		FROM rebalances`+where+` ORDER BY started_at DESC, id DESC LIMIT ? OFFSET ?`,
+		append(args, limit, query.Offset)...,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query rebalances: %w", err)
+	}
+	defer rows.Close()
+
+	records := []Record{}
+	for rows.Next() {
+		record, err := scanRecord(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		records = append(records, *record)
+	}
+
+	return records, total, rows.Err()
+}
+
+// Latest returns the most recent record matching the query's filters, or ErrNotFound
+func (s *Store) Latest(ctx context.Context, query Query) (*Record, error) {
+	query.Limit, query.Offset = 1, 0
+
+	records, _, err := s.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrNotFound
+	}
+	return &records[0], nil
+}
+
+func (q Query) where() (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	if len(q.Statuses) > 0 {
+		conditions = append(conditions, "status IN (?"+strings.Repeat(", ?", len(q.Statuses)-1)+")")
+		for _, status := range q.Statuses {
+			args = append(args, string(status))
+		}
+	}
+	if !q.Since.IsZero() {
+		conditions = append(conditions, "started_at >= ?")
+		args = append(args, q.Since.UnixMilli())
+	}
+	if !q.Until.IsZero() {
+		conditions = append(conditions, "started_at <= ?")
+		args = append(args, q.Until.UnixMilli())
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func scanRecord(rows *sql.Rows) (*Record, error) {
+	var record Record
+	var startedAt, finishedAt int64
+	var status string
+	var inputs, decision, targets sql.NullString
+
+	if err := rows.Scan(&record.ID, &startedAt, &finishedAt, &status, &record.Reason, &inputs, &decision, &targets,
+		&record.TxHash, &record.BlockNumber, &record.GasUsed); err != nil {
+		return nil, fmt.Errorf("failed to scan rebalance: %w", err)
+	}
+
+	record.StartedAt = time.UnixMilli(startedAt).UTC()
+	record.FinishedAt = time.UnixMilli(finishedAt).UTC()
+	record.Status = Status(status)
+	if inputs.Valid {
+		record.Inputs = json.RawMessage(inputs.String)
+	}
+	if decision.Valid {
+		record.Decision = json.RawMessage(decision.String)
+	}
+	if targets.Valid {
+		if err := json.Unmarshal([]byte(targets.String), &record.Targets); err != nil {
+			return nil, fmt.Errorf("failed to decode targets of rebalance %d: %w", record.ID, err)
+		}
+	}
+
+	return &record, nil
+}
+
+func nullableJSON(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
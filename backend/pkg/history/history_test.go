@@ -0,0 +1,210 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+func openMemory(t *testing.T) *Store {
+	t.Helper()
+	store, err := Open(":memory:")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+// seed saves a run every hour from start, cycling through succeeded, skipped and
+// failed, and returns their IDs in order. The last run starts together with the one
+// before it.
+func seed(t *testing.T, store *Store, runs int) []int64 {
+	t.Helper()
+	statuses := []Status{StatusSucceeded, StatusSkipped, StatusFailed}
+	ids := make([]int64, runs)
+	for i := range ids {
+		startedAt := start.Add(time.Duration(i) * time.Hour)
+		if i == runs-1 {
+			startedAt = start.Add(time.Duration(i-1) * time.Hour)
+		}
+		record := &Record{StartedAt: startedAt, FinishedAt: startedAt.Add(time.Minute), Status: statuses[i%len(statuses)]}
+		if err := store.Save(context.Background(), record); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+		ids[i] = record.ID
+	}
+	return ids
+}
+
+func idsOf(records []Record) []int64 {
+	ids := make([]int64, len(records))
+	for i, record := range records {
+		ids[i] = record.ID
+	}
+	return ids
+}
+
+func TestStoreSaveRoundTrip(t *testing.T) {
+	store := openMemory(t)
+
+	saved := &Record{
+		StartedAt:  start.Add(1500 * time.Microsecond),
+		FinishedAt: start.Add(2 * time.Minute),
+		Status:     StatusSucceeded,
+		Inputs:     json.RawMessage(`{"total_assets":"1000000000"}`),
+		Decision:   json.RawMessage(`{"rebalance":true}`),
+		Targets: []Allocation{
+			{Strategy: "0x3001", Name: "Aave V3", CurrentAmount: "600000000", TargetAmount: "500000000"},
+			{Strategy: "0x3002", CurrentAmount: "400000000", TargetAmount: "500000000"},
+		},
+		TxHash:      "0xabc",
+		BlockNumber: 1234,
+		GasUsed:     210000,
+	}
+	if err := store.Save(context.Background(), saved); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	skipped := &Record{StartedAt: start.Add(time.Hour), FinishedAt: start.Add(time.Hour), Status: StatusSkipped, Reason: "drift below threshold"}
+	if err := store.Save(context.Background(), skipped); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if saved.ID == 0 || skipped.ID == 0 || saved.ID == skipped.ID {
+		t.Fatalf("IDs = %d, %d; want distinct IDs", saved.ID, skipped.ID)
+	}
+
+	records, total, err := store.List(context.Background(), Query{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if total != 2 || len(records) != 2 {
+		t.Fatalf("List = %d records of %d, want 2", len(records), total)
+	}
+
+	// Times are stored with millisecond precision
+	want := *saved
+	want.StartedAt = start.Add(time.Millisecond)
+	if !reflect.DeepEqual(records[1], want) {
+		t.Errorf("record = %+v, want %+v", records[1], want)
+	}
+	if !reflect.DeepEqual(records[0], *skipped) {
+		t.Errorf("record = %+v, want %+v without inputs, decision or targets", records[0], *skipped)
+	}
+
+	if err := store.Save(context.Background(), &Record{Status: "pending"}); err == nil {
+		t.Error("Save accepted an unknown status")
+	}
+}
+
+func TestStoreListFilters(t *testing.T) {
+	store := openMemory(t)
+	// Started at hours 0-6, then another at hour 6: s k f s k f s k
+	ids := seed(t, store, 8)
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []int64
+	}{
+		{"everything, newest first", Query{}, []int64{ids[7], ids[6], ids[5], ids[4], ids[3], ids[2], ids[1], ids[0]}},
+		{"one status", Query{Statuses: []Status{StatusFailed}}, []int64{ids[5], ids[2]}},
+		{"several statuses", Query{Statuses: []Status{StatusSucceeded, StatusSkipped}}, []int64{ids[7], ids[6], ids[4], ids[3], ids[1], ids[0]}},
+		{"since, inclusive", Query{Since: start.Add(5 * time.Hour)}, []int64{ids[7], ids[6], ids[5]}},
+		{"until, inclusive", Query{Until: start.Add(time.Hour)}, []int64{ids[1], ids[0]}},
+		{"window and status", Query{Statuses: []Status{StatusSkipped}, Since: start.Add(time.Hour), Until: start.Add(4 * time.Hour)}, []int64{ids[4], ids[1]}},
+		{"no match", Query{Since: start.Add(24 * time.Hour)}, []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, total, err := store.List(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if got := idsOf(records); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IDs = %v, want %v", got, tt.want)
+			}
+			if total != len(tt.want) {
+				t.Errorf("total = %d, want %d", total, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestStoreListPagination(t *testing.T) {
+	store := openMemory(t)
+	ids := seed(t, store, 8)
+	newestFirst := []int64{ids[7], ids[6], ids[5], ids[4], ids[3], ids[2], ids[1], ids[0]}
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []int64
+		total int
+	}{
+		{"first page", Query{Limit: 3}, newestFirst[:3], 8},
+		{"second page", Query{Limit: 3, Offset: 3}, newestFirst[3:6], 8},
+		{"last partial page", Query{Limit: 3, Offset: 6}, newestFirst[6:], 8},
+		{"past the end", Query{Limit: 3, Offset: 9}, []int64{}, 8},
+		{"offset without limit", Query{Offset: 5}, newestFirst[5:], 8},
+		{"filtered page", Query{Statuses: []Status{StatusSucceeded, StatusSkipped}, Limit: 2, Offset: 2}, []int64{ids[4], ids[3]}, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, total, err := store.List(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if got := idsOf(records); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IDs = %v, want %v", got, tt.want)
+			}
+			if total != tt.total {
+				t.Errorf("total = %d, want %d ignoring the page", total, tt.total)
+			}
+		})
+	}
+}
+
+func TestStoreLatest(t *testing.T) {
+	store := openMemory(t)
+
+	if _, err := store.Latest(context.Background(), Query{}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Latest on an empty store = %v, want ErrNotFound", err)
+	}
+
+	ids := seed(t, store, 8)
+
+	tests := []struct {
+		name  string
+		query Query
+		want  int64
+	}{
+		// Ties on start time go to the later run
+		{"any", Query{}, ids[7]},
+		{"status", Query{Statuses: []Status{StatusFailed}}, ids[5]},
+		{"until", Query{Until: start.Add(3 * time.Hour)}, ids[3]},
+		{"ignores the page", Query{Limit: 5, Offset: 4}, ids[7]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := store.Latest(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("Latest: %v", err)
+			}
+			if record.ID != tt.want {
+				t.Errorf("Latest = %d, want %d", record.ID, tt.want)
+			}
+		})
+	}
+
+	if _, err := store.Latest(context.Background(), Query{Since: start.Add(24 * time.Hour)}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Latest with no match = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,533 @@
+package bindings
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// contractsDir is the Foundry project the committed ABIs are written from
+const contractsDir = "../../../contracts"
+
+// TestABIMatchesCompiledContracts compiles the Solidity sources with forge and
+// requires the committed ABI JSON to match the compiler's output exactly
+func TestABIMatchesCompiledContracts(t *testing.T) {
+	if _, err := exec.LookPath("forge"); err != nil {
+		t.Skip("forge is not installed")
+	}
+
+	for _, target := range targets {
+		t.Run(target.contract, func(t *testing.T) {
+			committed := loadABI(t, filepath.Join("abi", target.abiFile))
+
+			cmd := exec.Command("forge", "inspect", "src/"+target.sources[0]+":"+target.contract, "abi", "--json")
+			cmd.Dir = contractsDir
+			var stderr bytes.Buffer
+			cmd.Stderr = &stderr
+			output, err := cmd.Output()
+			if err != nil {
+				t.Fatalf("forge inspect failed (run forge install in contracts/ first): %v\n%s", err, stderr.String())
+			}
+			compiled, err := abi.JSON(bytes.NewReader(output))
+			if err != nil {
+				t.Fatalf("failed to parse forge ABI: %v", err)
+			}
+
+			for _, problem := range compareABI(committed, compiled) {
+				t.Errorf("%s: %s", target.abiFile, problem)
+			}
+		})
+	}
+}
+
+// TestABIMatchesSoliditySources checks the committed ABI JSON against the function,
+// public getter and event declarations in the Solidity sources. It needs no compiler,
+// so drift is caught even where forge is not installed.
+func TestABIMatchesSoliditySources(t *testing.T) {
+	src := filepath.Join(contractsDir, "src")
+	structs, err := loadStructs(src)
+	if err != nil {
+		t.Fatalf("failed to load Solidity structs: %v", err)
+	}
+
+	for _, target := range targets {
+		problems, err := check(target, src, "abi", structs)
+		if err != nil {
+			t.Fatalf("%s: %v", target.abiFile, err)
+		}
+		for _, problem := range problems {
+			t.Error(problem)
+		}
+	}
+}
+
+func loadABI(t *testing.T, path string) abi.ABI {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read ABI: %v", err)
+	}
+	parsed, err := abi.JSON(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to parse %s: %v", path, err)
+	}
+	return parsed
+}
+
+// compareABI reports functions, events and errors that differ between the committed
+// and compiled ABIs
+func compareABI(committed, compiled abi.ABI) []string {
+	var problems []string
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	names := map[string]bool{}
+	for name := range committed.Methods {
+		names[name] = true
+	}
+	for name := range compiled.Methods {
+		names[name] = true
+	}
+	for name := range names {
+		want, inCompiled := compiled.Methods[name]
+		got, inCommitted := committed.Methods[name]
+		switch {
+		case !inCommitted:
+			report("function %s is compiled but missing from the ABI", want.Sig)
+		case !inCompiled:
+			report("function %s is in the ABI but not compiled", got.Sig)
+		case got.Sig != want.Sig:
+			report("function %s has signature %s in the ABI", want.Sig, got.Sig)
+		case got.StateMutability != want.StateMutability:
+			report("function %s is %s in the ABI but compiles as %s", name, got.StateMutability, want.StateMutability)
+		case argTypes(got.Outputs) != argTypes(want.Outputs):
+			report("function %s returns (%s) in the ABI but compiles to (%s)", name, argTypes(got.Outputs), argTypes(want.Outputs))
+		}
+	}
+
+	names = map[string]bool{}
+	for name := range committed.Events {
+		names[name] = true
+	}
+	for name := range compiled.Events {
+		names[name] = true
+	}
+	for name := range names {
+		want, inCompiled := compiled.Events[name]
+		got, inCommitted := committed.Events[name]
+		switch {
+		case !inCommitted:
+			report("event %s is compiled but missing from the ABI", want.Sig)
+		case !inCompiled:
+			report("event %s is in the ABI but not compiled", got.Sig)
+		case got.Sig != want.Sig:
+			report("event %s has signature %s in the ABI", want.Sig, got.Sig)
+		default:
+			for i, arg := range got.Inputs {
+				if arg.Indexed != want.Inputs[i].Indexed {
+					report("event %s argument %d indexed=%v in the ABI, %v compiled", name, i, arg.Indexed, want.Inputs[i].Indexed)
+				}
+			}
+		}
+	}
+
+	for name, want := range compiled.Errors {
+		if got, ok := committed.Errors[name]; !ok || got.Sig != want.Sig {
+			report("error %s is compiled but missing from or different in the ABI", want.Sig)
+		}
+	}
+	for name, got := range committed.Errors {
+		if _, ok := compiled.Errors[name]; !ok {
+			report("error %s is in the ABI but not compiled", got.Sig)
+		}
+	}
+
+	sort.Strings(problems)
+	return problems
+}
+
+// target maps one ABI file to the Solidity files that declare its members
+type target struct {
+	abiFile  string
+	contract string // Contract forge compiles the ABI from, declared in sources[0]
+	sources  []string
+	// inherited lists base contracts whose members may appear in the ABI without
+	// being declared in sources
+	inherited []string
+}
+
+var targets = []target{
+	{
+		abiFile:   "AegisController.json",
+		contract:  "AegisController",
+		sources:   []string{"vault/AegisController.sol", "interfaces/IAegisController.sol"},
+		inherited: []string{"AccessControlUpgradeable", "PausableUpgradeable", "UUPSUpgradeable", "Initializable"},
+	},
+	{
+		abiFile:   "AegisVault.json",
+		contract:  "AegisVault",
+		sources:   []string{"vault/AegisVault.sol"},
+		inherited: []string{"ERC20Upgradeable", "ERC4626Upgradeable", "AccessControlUpgradeable", "PausableUpgradeable", "UUPSUpgradeable", "Initializable"},
+	},
+	{abiFile: "IAegisStrategy.json", contract: "IAegisStrategy", sources: []string{"interfaces/IAegisStrategy.sol"}},
+	{abiFile: "IRiskOracle.json", contract: "IRiskOracle", sources: []string{"interfaces/IRiskOracle.sol"}},
+	{abiFile: "IBridge.json", contract: "IBridge", sources: []string{"interfaces/IBridge.sol"}},
+}
+
+// inheritedMembers are the external members of the OpenZeppelin v4 base contracts
+var inheritedMembers = map[string][]string{
+	"AccessControlUpgradeable": {"DEFAULT_ADMIN_ROLE", "getRoleAdmin", "grantRole", "hasRole", "renounceRole", "revokeRole", "supportsInterface", "RoleAdminChanged", "RoleGranted", "RoleRevoked"},
+	"PausableUpgradeable":      {"paused", "Paused", "Unpaused"},
+	"UUPSUpgradeable":          {"proxiableUUID", "upgradeTo", "upgradeToAndCall", "AdminChanged", "BeaconUpgraded", "Upgraded"},
+	"Initializable":            {"Initialized"},
+	"ERC20Upgradeable":         {"allowance", "approve", "balanceOf", "decimals", "decreaseAllowance", "increaseAllowance", "name", "symbol", "totalSupply", "transfer", "transferFrom", "Approval", "Transfer"},
+	"ERC4626Upgradeable":       {"asset", "convertToAssets", "convertToShares", "maxDeposit", "maxMint", "maxRedeem", "maxWithdraw", "previewDeposit", "previewMint", "previewRedeem", "previewWithdraw", "Deposit", "Withdraw"},
+}
+
+// member is a function or event declared in Solidity
+type member struct {
+	name       string
+	event      bool
+	inputs     []string
+	indexed    []bool
+	outputs    []string
+	mutability string
+}
+
+func (m member) signature() string {
+	return fmt.Sprintf("%s(%s)", m.name, strings.Join(m.inputs, ","))
+}
+
+// check compares one ABI file with its Solidity sources
+func check(t target, contractsDir, abiDir string, structs map[string][]string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(abiDir, t.abiFile))
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := abi.JSON(strings.NewReader(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", t.abiFile, err)
+	}
+
+	declared := map[string]member{}
+	for _, source := range t.sources {
+		code, err := readSource(filepath.Join(contractsDir, source))
+		if err != nil {
+			return nil, err
+		}
+		members, err := parseMembers(code, structs)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", source, err)
+		}
+		for _, m := range members {
+			// Implementations come first and may narrow an interface's mutability
+			if _, ok := declared[m.name]; !ok {
+				declared[m.name] = m
+			}
+		}
+	}
+
+	var problems []string
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, t.abiFile+": "+fmt.Sprintf(format, args...))
+	}
+
+	for _, name := range sortedKeys(declared) {
+		m := declared[name]
+		if m.event {
+			event, ok := parsed.Events[m.name]
+			if !ok {
+				report("event %s is declared in Solidity but missing from the ABI", m.signature())
+				continue
+			}
+			if event.Sig != m.signature() {
+				report("event %s has signature %s in the ABI", m.signature(), event.Sig)
+				continue
+			}
+			for i, arg := range event.Inputs {
+				if arg.Indexed != m.indexed[i] {
+					report("event %s argument %d indexed=%v in the ABI, %v in Solidity", m.name, i, arg.Indexed, m.indexed[i])
+				}
+			}
+			continue
+		}
+
+		method, ok := parsed.Methods[m.name]
+		if !ok {
+			report("function %s is declared in Solidity but missing from the ABI", m.signature())
+			continue
+		}
+		if method.Sig != m.signature() {
+			report("function %s has signature %s in the ABI", m.signature(), method.Sig)
+			continue
+		}
+		if method.StateMutability != m.mutability {
+			report("function %s is %s in the ABI but %s in Solidity", m.name, method.StateMutability, m.mutability)
+		}
+		if outputs := argTypes(method.Outputs); outputs != strings.Join(m.outputs, ",") {
+			report("function %s returns (%s) in the ABI but (%s) in Solidity", m.name, outputs, strings.Join(m.outputs, ","))
+		}
+	}
+
+	allowed := map[string]bool{}
+	for _, base := range t.inherited {
+		for _, name := range inheritedMembers[base] {
+			allowed[name] = true
+		}
+	}
+	for name := range parsed.Methods {
+		if _, ok := declared[name]; !ok && !allowed[name] {
+			report("function %s is in the ABI but not declared in %s", parsed.Methods[name].Sig, strings.Join(t.sources, ", "))
+		}
+	}
+	for name := range parsed.Events {
+		if _, ok := declared[name]; !ok && !allowed[name] {
+			report("event %s is in the ABI but not declared in %s", parsed.Events[name].Sig, strings.Join(t.sources, ", "))
+		}
+	}
+
+	sort.Strings(problems)
+	return problems, nil
+}
+
+func argTypes(args abi.Arguments) string {
+	types := make([]string, len(args))
+	for i, arg := range args {
+		types[i] = arg.Type.String()
+	}
+	return strings.Join(types, ",")
+}
+
+var (
+	lineComment  = regexp.MustCompile(`//[^\n]*`)
+	blockComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+
+	structPattern   = regexp.MustCompile(`(?s)struct\s+(\w+)\s*\{([^}]*)\}`)
+	contractPattern = regexp.MustCompile(`(?:contract|interface)\s+(\w+)`)
+	functionPattern = regexp.MustCompile(`(?s)function\s+(\w+)\s*\(([^)]*)\)([^{;]*)[{;]`)
+	returnsPattern  = regexp.MustCompile(`(?s)returns\s*\(([^)]*)\)`)
+	eventPattern    = regexp.MustCompile(`(?s)event\s+(\w+)\s*\(([^)]*)\)\s*;`)
+	getterPattern   = regexp.MustCompile(`(?m)^\s*(mapping\s*\(.*\)|[\w.]+(?:\[\d*\])*)\s+public\s+(?:constant\s+|immutable\s+)?(\w+)\s*[=;]`)
+	mappingPattern  = regexp.MustCompile(`^mapping\s*\(\s*([\w.]+)\s*=>\s*(.*)\)$`)
+)
+
+func readSource(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	code := blockComment.ReplaceAllString(string(data), "")
+	return lineComment.ReplaceAllString(code, ""), nil
+}
+
+// loadStructs collects struct definitions from every Solidity file, keyed by both
+// their bare and contract-qualified names, as lists of member types
+func loadStructs(contractsDir string) (map[string][]string, error) {
+	raw := map[string][]string{}
+	err := filepath.Walk(contractsDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".sol") {
+			return err
+		}
+		code, err := readSource(path)
+		if err != nil {
+			return err
+		}
+		contract := ""
+		if match := contractPattern.FindStringSubmatch(code); match != nil {
+			contract = match[1]
+		}
+		for _, match := range structPattern.FindAllStringSubmatch(code, -1) {
+			var fields []string
+			for _, field := range strings.Split(match[2], ";") {
+				if parts := strings.Fields(field); len(parts) > 0 {
+					fields = append(fields, parts[0])
+				}
+			}
+			raw[match[1]] = fields
+			if contract != "" {
+				raw[contract+"."+match[1]] = fields
+			}
+		}
+		return nil
+	})
+	return raw, err
+}
+
+// parseMembers extracts external and public functions, public state variable
+// getters and events from Solidity source
+func parseMembers(code string, structs map[string][]string) ([]member, error) {
+	var members []member
+
+	for _, match := range functionPattern.FindAllStringSubmatch(code, -1) {
+		modifiers := " " + match[3] + " "
+		if !strings.Contains(modifiers, " external ") && !strings.Contains(modifiers, " public ") {
+			continue
+		}
+
+		inputs, _, err := parseParams(match[2], structs)
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %w", match[1], err)
+		}
+
+		var outputs []string
+		if returns := returnsPattern.FindStringSubmatch(match[3]); returns != nil {
+			if outputs, _, err = parseParams(returns[1], structs); err != nil {
+				return nil, fmt.Errorf("function %s returns: %w", match[1], err)
+			}
+		}
+
+		mutability := "nonpayable"
+		for _, m := range []string{"view", "pure", "payable"} {
+			if strings.Contains(modifiers, " "+m+" ") {
+				mutability = m
+			}
+		}
+
+		members = append(members, member{name: match[1], inputs: inputs, outputs: outputs, mutability: mutability})
+	}
+
+	for _, match := range getterPattern.FindAllStringSubmatch(code, -1) {
+		getter, err := parseGetter(match[2], match[1], structs)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, getter)
+	}
+
+	for _, match := range eventPattern.FindAllStringSubmatch(code, -1) {
+		inputs, indexed, err := parseParams(match[2], structs)
+		if err != nil {
+			return nil, fmt.Errorf("event %s: %w", match[1], err)
+		}
+		members = append(members, member{name: match[1], event: true, inputs: inputs, indexed: indexed})
+	}
+
+	return members, nil
+}
+
+// parseGetter describes the getter the compiler generates for a public state variable
+func parseGetter(name, typ string, structs map[string][]string) (member, error) {
+	getter := member{name: name, mutability: "view"}
+
+	for {
+		if match := mappingPattern.FindStringSubmatch(typ); match != nil {
+			key, err := canonicalType(match[1], structs)
+			if err != nil {
+				return getter, err
+			}
+			getter.inputs = append(getter.inputs, key)
+			typ = strings.TrimSpace(match[2])
+			continue
+		}
+		if strings.HasSuffix(typ, "]") {
+			getter.inputs = append(getter.inputs, "uint256")
+			typ = typ[:strings.LastIndex(typ, "[")]
+			continue
+		}
+		break
+	}
+
+	if fields, ok := structs[typ]; ok {
+		// Struct getters return the members that are not arrays or mappings
+		for _, field := range fields {
+			if strings.HasSuffix(field, "]") || strings.HasPrefix(field, "mapping") {
+				continue
+			}
+			canonical, err := canonicalType(field, structs)
+			if err != nil {
+				return getter, err
+			}
+			getter.outputs = append(getter.outputs, canonical)
+		}
+		return getter, nil
+	}
+
+	canonical, err := canonicalType(typ, structs)
+	if err != nil {
+		return getter, err
+	}
+	getter.outputs = []string{canonical}
+	return getter, nil
+}
+
+// parseParams returns the canonical ABI types of a parameter list and whether each is indexed
+func parseParams(params string, structs map[string][]string) ([]string, []bool, error) {
+	var types []string
+	var indexed []bool
+
+	for _, param := range strings.Split(params, ",") {
+		parts := strings.Fields(param)
+		if len(parts) == 0 {
+			continue
+		}
+		canonical, err := canonicalType(parts[0], structs)
+		if err != nil {
+			return nil, nil, err
+		}
+		types = append(types, canonical)
+
+		isIndexed := false
+		for _, part := range parts[1:] {
+			isIndexed = isIndexed || part == "indexed"
+		}
+		indexed = append(indexed, isIndexed)
+	}
+
+	return types, indexed, nil
+}
+
+var elementaryType = regexp.MustCompile(`^(address|bool|string|bytes\d*|u?int\d*)$`)
+
+// canonicalType converts a Solidity type to its canonical ABI form
+func canonicalType(typ string, structs map[string][]string) (string, error) {
+	suffix := ""
+	if i := strings.Index(typ, "["); i >= 0 {
+		typ, suffix = typ[:i], typ[i:]
+	}
+
+	switch {
+	case typ == "uint":
+		return "uint256" + suffix, nil
+	case typ == "int":
+		return "int256" + suffix, nil
+	case elementaryType.MatchString(typ):
+		return typ + suffix, nil
+	}
+
+	if fields, ok := structs[typ]; ok {
+		components := make([]string, len(fields))
+		for i, field := range fields {
+			canonical, err := canonicalType(field, structs)
+			if err != nil {
+				return "", err
+			}
+			components[i] = canonical
+		}
+		return "(" + strings.Join(components, ",") + ")" + suffix, nil
+	}
+
+	// Contract and interface types are addresses in the ABI
+	if name := typ[strings.LastIndex(typ, ".")+1:]; name != "" && name[0] >= 'A' && name[0] <= 'Z' {
+		return "address" + suffix, nil
+	}
+
+	return "", fmt.Errorf("unsupported type %q", typ)
+}
+
+func sortedKeys(members map[string]member) []string {
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
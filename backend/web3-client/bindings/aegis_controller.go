@@ -0,0 +1,3160 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IAegisControllerTargetAllocation is an auto generated low-level Go binding around an user-defined struct.
+type IAegisControllerTargetAllocation struct {
+	Strategy     common.Address
+	TargetAmount *big.Int
+}
+
+// AegisControllerMetaData contains all meta data concerning the AegisController contract.
+var AegisControllerMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"BASIS_POINTS\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DEFAULT_ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"KEEPER_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MAX_STRATEGIES\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"STRATEGIST_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"addStrategy\",\"inputs\":[{\"name\":\"strategy\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"allocationLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"emergencyWithdraw\",\"inputs\":[{\"name\":\"strategy\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getRoleAdmin\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getStrategies\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"harvestAll\",\"inputs\":[],\"outputs\":[{\"name\":\"totalYield\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"vault_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"asset_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"admin_\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isActiveStrategy\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lastRebalance\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"minRebalanceInterval\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pauseAll\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"proxiableUUID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"rebalance\",\"inputs\":[{\"name\":\"targets\",\"type\":\"tuple[]\",\"internalType\":\"structIAegisController.TargetAllocation[]\",\"components\":[{\"name\":\"strategy\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"targetAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"removeStrategy\",\"inputs\":[{\"name\":\"strategy\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setMinRebalanceInterval\",\"inputs\":[{\"name\":\"interval\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"strategyAllocation\",\"inputs\":[{\"name\":\"strategy\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"strategyConfigs\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"allocationLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"currentAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"isActive\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"addedAt\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalAssets\",\"inputs\":[],\"outputs\":[{\"name\":\"total\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unpauseAll\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"upgradeTo\",\"inputs\":[{\"name\":\"newImplementation\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"upgradeToAndCall\",\"inputs\":[{\"name\":\"newImplementation\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"vault\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"AdminChanged\",\"inputs\":[{\"name\":\"previousAdmin\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":false},{\"name\":\"newAdmin\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BeaconUpgraded\",\"inputs\":[{\"name\":\"beacon\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EmergencyWithdraw\",\"inputs\":[{\"name\":\"strategy\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint8\",\"internalType\":\"uint8\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Rebalanced\",\"inputs\":[{\"name\":\"keeper\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleAdminChanged\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true},{\"name\":\"previousAdminRole\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true},{\"name\":\"newAdminRole\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleGranted\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleRevoked\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"StrategyAdded\",\"inputs\":[{\"name\":\"strategy\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"allocationLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"StrategyHarvested\",\"inputs\":[{\"name\":\"strategy\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"yield\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"StrategyRemoved\",\"inputs\":[{\"name\":\"strategy\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Upgraded\",\"inputs\":[{\"name\":\"implementation\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false}]",
+}
+
+// AegisControllerABI is the input ABI used to generate the binding from.
+// Deprecated: Use AegisControllerMetaData.ABI instead.
+var AegisControllerABI = AegisControllerMetaData.ABI
+
+// AegisController is an auto generated Go binding around an Ethereum contract.
+type AegisController struct {
+	AegisControllerCaller     // Read-only binding to the contract
+	AegisControllerTransactor // Write-only binding to the contract
+	AegisControllerFilterer   // Log filterer for contract events
+}
+
+// AegisControllerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AegisControllerCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AegisControllerTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AegisControllerTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AegisControllerFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AegisControllerFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AegisControllerSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AegisControllerSession struct {
+	Contract     *AegisController  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AegisControllerCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AegisControllerCallerSession struct {
+	Contract *AegisControllerCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// AegisControllerTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AegisControllerTransactorSession struct {
+	Contract     *AegisControllerTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// AegisControllerRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AegisControllerRaw struct {
+	Contract *AegisController // Generic contract binding to access the raw methods on
+}
+
+// AegisControllerCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AegisControllerCallerRaw struct {
+	Contract *AegisControllerCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AegisControllerTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AegisControllerTransactorRaw struct {
+	Contract *AegisControllerTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAegisController creates a new instance of AegisController, bound to a specific deployed contract.
+func NewAegisController(address common.Address, backend bind.ContractBackend) (*AegisController, error) {
+	contract, err := bindAegisController(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisController{AegisControllerCaller: AegisControllerCaller{contract: contract}, AegisControllerTransactor: AegisControllerTransactor{contract: contract}, AegisControllerFilterer: AegisControllerFilterer{contract: contract}}, nil
+}
+
+// NewAegisControllerCaller creates a new read-only instance of AegisController, bound to a specific deployed contract.
+func NewAegisControllerCaller(address common.Address, caller bind.ContractCaller) (*AegisControllerCaller, error) {
+	contract, err := bindAegisController(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerCaller{contract: contract}, nil
+}
+
+// NewAegisControllerTransactor creates a new write-only instance of AegisController, bound to a specific deployed contract.
+func NewAegisControllerTransactor(address common.Address, transactor bind.ContractTransactor) (*AegisControllerTransactor, error) {
+	contract, err := bindAegisController(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerTransactor{contract: contract}, nil
+}
+
+// NewAegisControllerFilterer creates a new log filterer instance of AegisController, bound to a specific deployed contract.
+func NewAegisControllerFilterer(address common.Address, filterer bind.ContractFilterer) (*AegisControllerFilterer, error) {
+	contract, err := bindAegisController(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerFilterer{contract: contract}, nil
+}
+
+// bindAegisController binds a generic wrapper to an already deployed contract.
+func bindAegisController(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AegisControllerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AegisController *AegisControllerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AegisController.Contract.AegisControllerCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AegisController *AegisControllerRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AegisController.Contract.AegisControllerTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AegisController *AegisControllerRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AegisController.Contract.AegisControllerTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AegisController *AegisControllerCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AegisController.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AegisController *AegisControllerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AegisController.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AegisController *AegisControllerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AegisController.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerSession) ADMINROLE() ([32]byte, error) {
+	return _AegisController.Contract.ADMINROLE(&_AegisController.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerCallerSession) ADMINROLE() ([32]byte, error) {
+	return _AegisController.Contract.ADMINROLE(&_AegisController.CallOpts)
+}
+
+// BASISPOINTS is a free data retrieval call binding the contract method 0xe1f1c4a7.
+//
+// Solidity: function BASIS_POINTS() view returns(uint256)
+func (_AegisController *AegisControllerCaller) BASISPOINTS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "BASIS_POINTS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BASISPOINTS is a free data retrieval call binding the contract method 0xe1f1c4a7.
+//
+// Solidity: function BASIS_POINTS() view returns(uint256)
+func (_AegisController *AegisControllerSession) BASISPOINTS() (*big.Int, error) {
+	return _AegisController.Contract.BASISPOINTS(&_AegisController.CallOpts)
+}
+
+// BASISPOINTS is a free data retrieval call binding the contract method 0xe1f1c4a7.
+//
+// Solidity: function BASIS_POINTS() view returns(uint256)
+func (_AegisController *AegisControllerCallerSession) BASISPOINTS() (*big.Int, error) {
+	return _AegisController.Contract.BASISPOINTS(&_AegisController.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _AegisController.Contract.DEFAULTADMINROLE(&_AegisController.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _AegisController.Contract.DEFAULTADMINROLE(&_AegisController.CallOpts)
+}
+
+// KEEPERROLE is a free data retrieval call binding the contract method 0x364bc15a.
+//
+// Solidity: function KEEPER_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerCaller) KEEPERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "KEEPER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// KEEPERROLE is a free data retrieval call binding the contract method 0x364bc15a.
+//
+// Solidity: function KEEPER_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerSession) KEEPERROLE() ([32]byte, error) {
+	return _AegisController.Contract.KEEPERROLE(&_AegisController.CallOpts)
+}
+
+// KEEPERROLE is a free data retrieval call binding the contract method 0x364bc15a.
+//
+// Solidity: function KEEPER_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerCallerSession) KEEPERROLE() ([32]byte, error) {
+	return _AegisController.Contract.KEEPERROLE(&_AegisController.CallOpts)
+}
+
+// MAXSTRATEGIES is a free data retrieval call binding the contract method 0x767f06ae.
+//
+// Solidity: function MAX_STRATEGIES() view returns(uint256)
+func (_AegisController *AegisControllerCaller) MAXSTRATEGIES(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "MAX_STRATEGIES")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXSTRATEGIES is a free data retrieval call binding the contract method 0x767f06ae.
+//
+// Solidity: function MAX_STRATEGIES() view returns(uint256)
+func (_AegisController *AegisControllerSession) MAXSTRATEGIES() (*big.Int, error) {
+	return _AegisController.Contract.MAXSTRATEGIES(&_AegisController.CallOpts)
+}
+
+// MAXSTRATEGIES is a free data retrieval call binding the contract method 0x767f06ae.
+//
+// Solidity: function MAX_STRATEGIES() view returns(uint256)
+func (_AegisController *AegisControllerCallerSession) MAXSTRATEGIES() (*big.Int, error) {
+	return _AegisController.Contract.MAXSTRATEGIES(&_AegisController.CallOpts)
+}
+
+// STRATEGISTROLE is a free data retrieval call binding the contract method 0xa378a324.
+//
+// Solidity: function STRATEGIST_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerCaller) STRATEGISTROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "STRATEGIST_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// STRATEGISTROLE is a free data retrieval call binding the contract method 0xa378a324.
+//
+// Solidity: function STRATEGIST_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerSession) STRATEGISTROLE() ([32]byte, error) {
+	return _AegisController.Contract.STRATEGISTROLE(&_AegisController.CallOpts)
+}
+
+// STRATEGISTROLE is a free data retrieval call binding the contract method 0xa378a324.
+//
+// Solidity: function STRATEGIST_ROLE() view returns(bytes32)
+func (_AegisController *AegisControllerCallerSession) STRATEGISTROLE() ([32]byte, error) {
+	return _AegisController.Contract.STRATEGISTROLE(&_AegisController.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_AegisController *AegisControllerCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_AegisController *AegisControllerSession) Asset() (common.Address, error) {
+	return _AegisController.Contract.Asset(&_AegisController.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_AegisController *AegisControllerCallerSession) Asset() (common.Address, error) {
+	return _AegisController.Contract.Asset(&_AegisController.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_AegisController *AegisControllerCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_AegisController *AegisControllerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _AegisController.Contract.GetRoleAdmin(&_AegisController.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_AegisController *AegisControllerCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _AegisController.Contract.GetRoleAdmin(&_AegisController.CallOpts, role)
+}
+
+// GetStrategies is a free data retrieval call binding the contract method 0xb49a60bb.
+//
+// Solidity: function getStrategies() view returns(address[])
+func (_AegisController *AegisControllerCaller) GetStrategies(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "getStrategies")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetStrategies is a free data retrieval call binding the contract method 0xb49a60bb.
+//
+// Solidity: function getStrategies() view returns(address[])
+func (_AegisController *AegisControllerSession) GetStrategies() ([]common.Address, error) {
+	return _AegisController.Contract.GetStrategies(&_AegisController.CallOpts)
+}
+
+// GetStrategies is a free data retrieval call binding the contract method 0xb49a60bb.
+//
+// Solidity: function getStrategies() view returns(address[])
+func (_AegisController *AegisControllerCallerSession) GetStrategies() ([]common.Address, error) {
+	return _AegisController.Contract.GetStrategies(&_AegisController.CallOpts)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_AegisController *AegisControllerCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_AegisController *AegisControllerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _AegisController.Contract.HasRole(&_AegisController.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_AegisController *AegisControllerCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _AegisController.Contract.HasRole(&_AegisController.CallOpts, role, account)
+}
+
+// IsActiveStrategy is a free data retrieval call binding the contract method 0xd2581503.
+//
+// Solidity: function isActiveStrategy(address ) view returns(bool)
+func (_AegisController *AegisControllerCaller) IsActiveStrategy(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "isActiveStrategy", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsActiveStrategy is a free data retrieval call binding the contract method 0xd2581503.
+//
+// Solidity: function isActiveStrategy(address ) view returns(bool)
+func (_AegisController *AegisControllerSession) IsActiveStrategy(arg0 common.Address) (bool, error) {
+	return _AegisController.Contract.IsActiveStrategy(&_AegisController.CallOpts, arg0)
+}
+
+// IsActiveStrategy is a free data retrieval call binding the contract method 0xd2581503.
+//
+// Solidity: function isActiveStrategy(address ) view returns(bool)
+func (_AegisController *AegisControllerCallerSession) IsActiveStrategy(arg0 common.Address) (bool, error) {
+	return _AegisController.Contract.IsActiveStrategy(&_AegisController.CallOpts, arg0)
+}
+
+// LastRebalance is a free data retrieval call binding the contract method 0x106b9ca1.
+//
+// Solidity: function lastRebalance() view returns(uint256)
+func (_AegisController *AegisControllerCaller) LastRebalance(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "lastRebalance")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastRebalance is a free data retrieval call binding the contract method 0x106b9ca1.
+//
+// Solidity: function lastRebalance() view returns(uint256)
+func (_AegisController *AegisControllerSession) LastRebalance() (*big.Int, error) {
+	return _AegisController.Contract.LastRebalance(&_AegisController.CallOpts)
+}
+
+// LastRebalance is a free data retrieval call binding the contract method 0x106b9ca1.
+//
+// Solidity: function lastRebalance() view returns(uint256)
+func (_AegisController *AegisControllerCallerSession) LastRebalance() (*big.Int, error) {
+	return _AegisController.Contract.LastRebalance(&_AegisController.CallOpts)
+}
+
+// MinRebalanceInterval is a free data retrieval call binding the contract method 0x6c252eb2.
+//
+// Solidity: function minRebalanceInterval() view returns(uint256)
+func (_AegisController *AegisControllerCaller) MinRebalanceInterval(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "minRebalanceInterval")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinRebalanceInterval is a free data retrieval call binding the contract method 0x6c252eb2.
+//
+// Solidity: function minRebalanceInterval() view returns(uint256)
+func (_AegisController *AegisControllerSession) MinRebalanceInterval() (*big.Int, error) {
+	return _AegisController.Contract.MinRebalanceInterval(&_AegisController.CallOpts)
+}
+
+// MinRebalanceInterval is a free data retrieval call binding the contract method 0x6c252eb2.
+//
+// Solidity: function minRebalanceInterval() view returns(uint256)
+func (_AegisController *AegisControllerCallerSession) MinRebalanceInterval() (*big.Int, error) {
+	return _AegisController.Contract.MinRebalanceInterval(&_AegisController.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_AegisController *AegisControllerCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_AegisController *AegisControllerSession) Paused() (bool, error) {
+	return _AegisController.Contract.Paused(&_AegisController.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_AegisController *AegisControllerCallerSession) Paused() (bool, error) {
+	return _AegisController.Contract.Paused(&_AegisController.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_AegisController *AegisControllerCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_AegisController *AegisControllerSession) ProxiableUUID() ([32]byte, error) {
+	return _AegisController.Contract.ProxiableUUID(&_AegisController.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_AegisController *AegisControllerCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _AegisController.Contract.ProxiableUUID(&_AegisController.CallOpts)
+}
+
+// StrategyAllocation is a free data retrieval call binding the contract method 0x4ef2a64f.
+//
+// Solidity: function strategyAllocation(address strategy) view returns(uint256)
+func (_AegisController *AegisControllerCaller) StrategyAllocation(opts *bind.CallOpts, strategy common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "strategyAllocation", strategy)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StrategyAllocation is a free data retrieval call binding the contract method 0x4ef2a64f.
+//
+// Solidity: function strategyAllocation(address strategy) view returns(uint256)
+func (_AegisController *AegisControllerSession) StrategyAllocation(strategy common.Address) (*big.Int, error) {
+	return _AegisController.Contract.StrategyAllocation(&_AegisController.CallOpts, strategy)
+}
+
+// StrategyAllocation is a free data retrieval call binding the contract method 0x4ef2a64f.
+//
+// Solidity: function strategyAllocation(address strategy) view returns(uint256)
+func (_AegisController *AegisControllerCallerSession) StrategyAllocation(strategy common.Address) (*big.Int, error) {
+	return _AegisController.Contract.StrategyAllocation(&_AegisController.CallOpts, strategy)
+}
+
+// StrategyConfigs is a free data retrieval call binding the contract method 0x9cca147f.
+//
+// Solidity: function strategyConfigs(address ) view returns(uint256 allocationLimit, uint256 currentAllocation, bool isActive, uint256 addedAt)
+func (_AegisController *AegisControllerCaller) StrategyConfigs(opts *bind.CallOpts, arg0 common.Address) (struct {
+	AllocationLimit   *big.Int
+	CurrentAllocation *big.Int
+	IsActive          bool
+	AddedAt           *big.Int
+}, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "strategyConfigs", arg0)
+
+	outstruct := new(struct {
+		AllocationLimit   *big.Int
+		CurrentAllocation *big.Int
+		IsActive          bool
+		AddedAt           *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.AllocationLimit = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.CurrentAllocation = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.IsActive = *abi.ConvertType(out[2], new(bool)).(*bool)
+	outstruct.AddedAt = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// StrategyConfigs is a free data retrieval call binding the contract method 0x9cca147f.
+//
+// Solidity: function strategyConfigs(address ) view returns(uint256 allocationLimit, uint256 currentAllocation, bool isActive, uint256 addedAt)
+func (_AegisController *AegisControllerSession) StrategyConfigs(arg0 common.Address) (struct {
+	AllocationLimit   *big.Int
+	CurrentAllocation *big.Int
+	IsActive          bool
+	AddedAt           *big.Int
+}, error) {
+	return _AegisController.Contract.StrategyConfigs(&_AegisController.CallOpts, arg0)
+}
+
+// StrategyConfigs is a free data retrieval call binding the contract method 0x9cca147f.
+//
+// Solidity: function strategyConfigs(address ) view returns(uint256 allocationLimit, uint256 currentAllocation, bool isActive, uint256 addedAt)
+func (_AegisController *AegisControllerCallerSession) StrategyConfigs(arg0 common.Address) (struct {
+	AllocationLimit   *big.Int
+	CurrentAllocation *big.Int
+	IsActive          bool
+	AddedAt           *big.Int
+}, error) {
+	return _AegisController.Contract.StrategyConfigs(&_AegisController.CallOpts, arg0)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_AegisController *AegisControllerCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_AegisController *AegisControllerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _AegisController.Contract.SupportsInterface(&_AegisController.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_AegisController *AegisControllerCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _AegisController.Contract.SupportsInterface(&_AegisController.CallOpts, interfaceId)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256 total)
+func (_AegisController *AegisControllerCaller) TotalAssets(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "totalAssets")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256 total)
+func (_AegisController *AegisControllerSession) TotalAssets() (*big.Int, error) {
+	return _AegisController.Contract.TotalAssets(&_AegisController.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256 total)
+func (_AegisController *AegisControllerCallerSession) TotalAssets() (*big.Int, error) {
+	return _AegisController.Contract.TotalAssets(&_AegisController.CallOpts)
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_AegisController *AegisControllerCaller) Vault(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AegisController.contract.Call(opts, &out, "vault")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_AegisController *AegisControllerSession) Vault() (common.Address, error) {
+	return _AegisController.Contract.Vault(&_AegisController.CallOpts)
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_AegisController *AegisControllerCallerSession) Vault() (common.Address, error) {
+	return _AegisController.Contract.Vault(&_AegisController.CallOpts)
+}
+
+// AddStrategy is a paid mutator transaction binding the contract method 0xc9411e22.
+//
+// Solidity: function addStrategy(address strategy, uint256 allocationLimit) returns()
+func (_AegisController *AegisControllerTransactor) AddStrategy(opts *bind.TransactOpts, strategy common.Address, allocationLimit *big.Int) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "addStrategy", strategy, allocationLimit)
+}
+
+// AddStrategy is a paid mutator transaction binding the contract method 0xc9411e22.
+//
+// Solidity: function addStrategy(address strategy, uint256 allocationLimit) returns()
+func (_AegisController *AegisControllerSession) AddStrategy(strategy common.Address, allocationLimit *big.Int) (*types.Transaction, error) {
+	return _AegisController.Contract.AddStrategy(&_AegisController.TransactOpts, strategy, allocationLimit)
+}
+
+// AddStrategy is a paid mutator transaction binding the contract method 0xc9411e22.
+//
+// Solidity: function addStrategy(address strategy, uint256 allocationLimit) returns()
+func (_AegisController *AegisControllerTransactorSession) AddStrategy(strategy common.Address, allocationLimit *big.Int) (*types.Transaction, error) {
+	return _AegisController.Contract.AddStrategy(&_AegisController.TransactOpts, strategy, allocationLimit)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x6ff1c9bc.
+//
+// Solidity: function emergencyWithdraw(address strategy) returns()
+func (_AegisController *AegisControllerTransactor) EmergencyWithdraw(opts *bind.TransactOpts, strategy common.Address) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "emergencyWithdraw", strategy)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x6ff1c9bc.
+//
+// Solidity: function emergencyWithdraw(address strategy) returns()
+func (_AegisController *AegisControllerSession) EmergencyWithdraw(strategy common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.EmergencyWithdraw(&_AegisController.TransactOpts, strategy)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x6ff1c9bc.
+//
+// Solidity: function emergencyWithdraw(address strategy) returns()
+func (_AegisController *AegisControllerTransactorSession) EmergencyWithdraw(strategy common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.EmergencyWithdraw(&_AegisController.TransactOpts, strategy)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_AegisController *AegisControllerTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_AegisController *AegisControllerSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.GrantRole(&_AegisController.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_AegisController *AegisControllerTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.GrantRole(&_AegisController.TransactOpts, role, account)
+}
+
+// HarvestAll is a paid mutator transaction binding the contract method 0x8ed955b9.
+//
+// Solidity: function harvestAll() returns(uint256 totalYield)
+func (_AegisController *AegisControllerTransactor) HarvestAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "harvestAll")
+}
+
+// HarvestAll is a paid mutator transaction binding the contract method 0x8ed955b9.
+//
+// Solidity: function harvestAll() returns(uint256 totalYield)
+func (_AegisController *AegisControllerSession) HarvestAll() (*types.Transaction, error) {
+	return _AegisController.Contract.HarvestAll(&_AegisController.TransactOpts)
+}
+
+// HarvestAll is a paid mutator transaction binding the contract method 0x8ed955b9.
+//
+// Solidity: function harvestAll() returns(uint256 totalYield)
+func (_AegisController *AegisControllerTransactorSession) HarvestAll() (*types.Transaction, error) {
+	return _AegisController.Contract.HarvestAll(&_AegisController.TransactOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc0c53b8b.
+//
+// Solidity: function initialize(address vault_, address asset_, address admin_) returns()
+func (_AegisController *AegisControllerTransactor) Initialize(opts *bind.TransactOpts, vault_ common.Address, asset_ common.Address, admin_ common.Address) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "initialize", vault_, asset_, admin_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc0c53b8b.
+//
+// Solidity: function initialize(address vault_, address asset_, address admin_) returns()
+func (_AegisController *AegisControllerSession) Initialize(vault_ common.Address, asset_ common.Address, admin_ common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.Initialize(&_AegisController.TransactOpts, vault_, asset_, admin_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc0c53b8b.
+//
+// Solidity: function initialize(address vault_, address asset_, address admin_) returns()
+func (_AegisController *AegisControllerTransactorSession) Initialize(vault_ common.Address, asset_ common.Address, admin_ common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.Initialize(&_AegisController.TransactOpts, vault_, asset_, admin_)
+}
+
+// PauseAll is a paid mutator transaction binding the contract method 0x595c6a67.
+//
+// Solidity: function pauseAll() returns()
+func (_AegisController *AegisControllerTransactor) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "pauseAll")
+}
+
+// PauseAll is a paid mutator transaction binding the contract method 0x595c6a67.
+//
+// Solidity: function pauseAll() returns()
+func (_AegisController *AegisControllerSession) PauseAll() (*types.Transaction, error) {
+	return _AegisController.Contract.PauseAll(&_AegisController.TransactOpts)
+}
+
+// PauseAll is a paid mutator transaction binding the contract method 0x595c6a67.
+//
+// Solidity: function pauseAll() returns()
+func (_AegisController *AegisControllerTransactorSession) PauseAll() (*types.Transaction, error) {
+	return _AegisController.Contract.PauseAll(&_AegisController.TransactOpts)
+}
+
+// Rebalance is a paid mutator transaction binding the contract method 0xf009a1e0.
+//
+// Solidity: function rebalance((address,uint256)[] targets) returns()
+func (_AegisController *AegisControllerTransactor) Rebalance(opts *bind.TransactOpts, targets []IAegisControllerTargetAllocation) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "rebalance", targets)
+}
+
+// Rebalance is a paid mutator transaction binding the contract method 0xf009a1e0.
+//
+// Solidity: function rebalance((address,uint256)[] targets) returns()
+func (_AegisController *AegisControllerSession) Rebalance(targets []IAegisControllerTargetAllocation) (*types.Transaction, error) {
+	return _AegisController.Contract.Rebalance(&_AegisController.TransactOpts, targets)
+}
+
+// Rebalance is a paid mutator transaction binding the contract method 0xf009a1e0.
+//
+// Solidity: function rebalance((address,uint256)[] targets) returns()
+func (_AegisController *AegisControllerTransactorSession) Rebalance(targets []IAegisControllerTargetAllocation) (*types.Transaction, error) {
+	return _AegisController.Contract.Rebalance(&_AegisController.TransactOpts, targets)
+}
+
+// RemoveStrategy is a paid mutator transaction binding the contract method 0x175188e8.
+//
+// Solidity: function removeStrategy(address strategy) returns()
+func (_AegisController *AegisControllerTransactor) RemoveStrategy(opts *bind.TransactOpts, strategy common.Address) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "removeStrategy", strategy)
+}
+
+// RemoveStrategy is a paid mutator transaction binding the contract method 0x175188e8.
+//
+// Solidity: function removeStrategy(address strategy) returns()
+func (_AegisController *AegisControllerSession) RemoveStrategy(strategy common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.RemoveStrategy(&_AegisController.TransactOpts, strategy)
+}
+
+// RemoveStrategy is a paid mutator transaction binding the contract method 0x175188e8.
+//
+// Solidity: function removeStrategy(address strategy) returns()
+func (_AegisController *AegisControllerTransactorSession) RemoveStrategy(strategy common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.RemoveStrategy(&_AegisController.TransactOpts, strategy)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_AegisController *AegisControllerTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "renounceRole", role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_AegisController *AegisControllerSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.RenounceRole(&_AegisController.TransactOpts, role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_AegisController *AegisControllerTransactorSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.RenounceRole(&_AegisController.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_AegisController *AegisControllerTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_AegisController *AegisControllerSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.RevokeRole(&_AegisController.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_AegisController *AegisControllerTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.RevokeRole(&_AegisController.TransactOpts, role, account)
+}
+
+// SetMinRebalanceInterval is a paid mutator transaction binding the contract method 0x4e18f760.
+//
+// Solidity: function setMinRebalanceInterval(uint256 interval) returns()
+func (_AegisController *AegisControllerTransactor) SetMinRebalanceInterval(opts *bind.TransactOpts, interval *big.Int) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "setMinRebalanceInterval", interval)
+}
+
+// SetMinRebalanceInterval is a paid mutator transaction binding the contract method 0x4e18f760.
+//
+// Solidity: function setMinRebalanceInterval(uint256 interval) returns()
+func (_AegisController *AegisControllerSession) SetMinRebalanceInterval(interval *big.Int) (*types.Transaction, error) {
+	return _AegisController.Contract.SetMinRebalanceInterval(&_AegisController.TransactOpts, interval)
+}
+
+// SetMinRebalanceInterval is a paid mutator transaction binding the contract method 0x4e18f760.
+//
+// Solidity: function setMinRebalanceInterval(uint256 interval) returns()
+func (_AegisController *AegisControllerTransactorSession) SetMinRebalanceInterval(interval *big.Int) (*types.Transaction, error) {
+	return _AegisController.Contract.SetMinRebalanceInterval(&_AegisController.TransactOpts, interval)
+}
+
+// UnpauseAll is a paid mutator transaction binding the contract method 0x8a2ddd03.
+//
+// Solidity: function unpauseAll() returns()
+func (_AegisController *AegisControllerTransactor) UnpauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "unpauseAll")
+}
+
+// UnpauseAll is a paid mutator transaction binding the contract method 0x8a2ddd03.
+//
+// Solidity: function unpauseAll() returns()
+func (_AegisController *AegisControllerSession) UnpauseAll() (*types.Transaction, error) {
+	return _AegisController.Contract.UnpauseAll(&_AegisController.TransactOpts)
+}
+
+// UnpauseAll is a paid mutator transaction binding the contract method 0x8a2ddd03.
+//
+// Solidity: function unpauseAll() returns()
+func (_AegisController *AegisControllerTransactorSession) UnpauseAll() (*types.Transaction, error) {
+	return _AegisController.Contract.UnpauseAll(&_AegisController.TransactOpts)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_AegisController *AegisControllerTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_AegisController *AegisControllerSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.UpgradeTo(&_AegisController.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_AegisController *AegisControllerTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _AegisController.Contract.UpgradeTo(&_AegisController.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_AegisController *AegisControllerTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _AegisController.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_AegisController *AegisControllerSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _AegisController.Contract.UpgradeToAndCall(&_AegisController.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_AegisController *AegisControllerTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _AegisController.Contract.UpgradeToAndCall(&_AegisController.TransactOpts, newImplementation, data)
+}
+
+// AegisControllerAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the AegisController contract.
+type AegisControllerAdminChangedIterator struct {
+	Event *AegisControllerAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerAdminChanged represents a AdminChanged event raised by the AegisController contract.
+type AegisControllerAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_AegisController *AegisControllerFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*AegisControllerAdminChangedIterator, error) {
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerAdminChangedIterator{contract: _AegisController.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_AegisController *AegisControllerFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *AegisControllerAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerAdminChanged)
+				if err := _AegisController.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_AegisController *AegisControllerFilterer) ParseAdminChanged(log types.Log) (*AegisControllerAdminChanged, error) {
+	event := new(AegisControllerAdminChanged)
+	if err := _AegisController.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the AegisController contract.
+type AegisControllerBeaconUpgradedIterator struct {
+	Event *AegisControllerBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerBeaconUpgraded represents a BeaconUpgraded event raised by the AegisController contract.
+type AegisControllerBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_AegisController *AegisControllerFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*AegisControllerBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerBeaconUpgradedIterator{contract: _AegisController.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_AegisController *AegisControllerFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *AegisControllerBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerBeaconUpgraded)
+				if err := _AegisController.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_AegisController *AegisControllerFilterer) ParseBeaconUpgraded(log types.Log) (*AegisControllerBeaconUpgraded, error) {
+	event := new(AegisControllerBeaconUpgraded)
+	if err := _AegisController.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerEmergencyWithdrawIterator is returned from FilterEmergencyWithdraw and is used to iterate over the raw logs and unpacked data for EmergencyWithdraw events raised by the AegisController contract.
+type AegisControllerEmergencyWithdrawIterator struct {
+	Event *AegisControllerEmergencyWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerEmergencyWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerEmergencyWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerEmergencyWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerEmergencyWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerEmergencyWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerEmergencyWithdraw represents a EmergencyWithdraw event raised by the AegisController contract.
+type AegisControllerEmergencyWithdraw struct {
+	Strategy common.Address
+	Amount   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterEmergencyWithdraw is a free log retrieval operation binding the contract event 0x5fafa99d0643513820be26656b45130b01e1c03062e1266bf36f88cbd3bd9695.
+//
+// Solidity: event EmergencyWithdraw(address indexed strategy, uint256 amount)
+func (_AegisController *AegisControllerFilterer) FilterEmergencyWithdraw(opts *bind.FilterOpts, strategy []common.Address) (*AegisControllerEmergencyWithdrawIterator, error) {
+
+	var strategyRule []interface{}
+	for _, strategyItem := range strategy {
+		strategyRule = append(strategyRule, strategyItem)
+	}
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "EmergencyWithdraw", strategyRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerEmergencyWithdrawIterator{contract: _AegisController.contract, event: "EmergencyWithdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchEmergencyWithdraw is a free log subscription operation binding the contract event 0x5fafa99d0643513820be26656b45130b01e1c03062e1266bf36f88cbd3bd9695.
+//
+// Solidity: event EmergencyWithdraw(address indexed strategy, uint256 amount)
+func (_AegisController *AegisControllerFilterer) WatchEmergencyWithdraw(opts *bind.WatchOpts, sink chan<- *AegisControllerEmergencyWithdraw, strategy []common.Address) (event.Subscription, error) {
+
+	var strategyRule []interface{}
+	for _, strategyItem := range strategy {
+		strategyRule = append(strategyRule, strategyItem)
+	}
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "EmergencyWithdraw", strategyRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerEmergencyWithdraw)
+				if err := _AegisController.contract.UnpackLog(event, "EmergencyWithdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEmergencyWithdraw is a log parse operation binding the contract event 0x5fafa99d0643513820be26656b45130b01e1c03062e1266bf36f88cbd3bd9695.
+//
+// Solidity: event EmergencyWithdraw(address indexed strategy, uint256 amount)
+func (_AegisController *AegisControllerFilterer) ParseEmergencyWithdraw(log types.Log) (*AegisControllerEmergencyWithdraw, error) {
+	event := new(AegisControllerEmergencyWithdraw)
+	if err := _AegisController.contract.UnpackLog(event, "EmergencyWithdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the AegisController contract.
+type AegisControllerInitializedIterator struct {
+	Event *AegisControllerInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerInitialized represents a Initialized event raised by the AegisController contract.
+type AegisControllerInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_AegisController *AegisControllerFilterer) FilterInitialized(opts *bind.FilterOpts) (*AegisControllerInitializedIterator, error) {
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerInitializedIterator{contract: _AegisController.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_AegisController *AegisControllerFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *AegisControllerInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerInitialized)
+				if err := _AegisController.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_AegisController *AegisControllerFilterer) ParseInitialized(log types.Log) (*AegisControllerInitialized, error) {
+	event := new(AegisControllerInitialized)
+	if err := _AegisController.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the AegisController contract.
+type AegisControllerPausedIterator struct {
+	Event *AegisControllerPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerPaused represents a Paused event raised by the AegisController contract.
+type AegisControllerPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_AegisController *AegisControllerFilterer) FilterPaused(opts *bind.FilterOpts) (*AegisControllerPausedIterator, error) {
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerPausedIterator{contract: _AegisController.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_AegisController *AegisControllerFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *AegisControllerPaused) (event.Subscription, error) {
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerPaused)
+				if err := _AegisController.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_AegisController *AegisControllerFilterer) ParsePaused(log types.Log) (*AegisControllerPaused, error) {
+	event := new(AegisControllerPaused)
+	if err := _AegisController.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerRebalancedIterator is returned from FilterRebalanced and is used to iterate over the raw logs and unpacked data for Rebalanced events raised by the AegisController contract.
+type AegisControllerRebalancedIterator struct {
+	Event *AegisControllerRebalanced // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerRebalancedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerRebalanced)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerRebalanced)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerRebalancedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerRebalancedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerRebalanced represents a Rebalanced event raised by the AegisController contract.
+type AegisControllerRebalanced struct {
+	Keeper    common.Address
+	Timestamp *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterRebalanced is a free log retrieval operation binding the contract event 0x1427d1942829759938581ce754fd0f7f116bfb7a4b77f80f0cb32cd62c2138c7.
+//
+// Solidity: event Rebalanced(address indexed keeper, uint256 timestamp)
+func (_AegisController *AegisControllerFilterer) FilterRebalanced(opts *bind.FilterOpts, keeper []common.Address) (*AegisControllerRebalancedIterator, error) {
+
+	var keeperRule []interface{}
+	for _, keeperItem := range keeper {
+		keeperRule = append(keeperRule, keeperItem)
+	}
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "Rebalanced", keeperRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerRebalancedIterator{contract: _AegisController.contract, event: "Rebalanced", logs: logs, sub: sub}, nil
+}
+
+// WatchRebalanced is a free log subscription operation binding the contract event 0x1427d1942829759938581ce754fd0f7f116bfb7a4b77f80f0cb32cd62c2138c7.
+//
+// Solidity: event Rebalanced(address indexed keeper, uint256 timestamp)
+func (_AegisController *AegisControllerFilterer) WatchRebalanced(opts *bind.WatchOpts, sink chan<- *AegisControllerRebalanced, keeper []common.Address) (event.Subscription, error) {
+
+	var keeperRule []interface{}
+	for _, keeperItem := range keeper {
+		keeperRule = append(keeperRule, keeperItem)
+	}
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "Rebalanced", keeperRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerRebalanced)
+				if err := _AegisController.contract.UnpackLog(event, "Rebalanced", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRebalanced is a log parse operation binding the contract event 0x1427d1942829759938581ce754fd0f7f116bfb7a4b77f80f0cb32cd62c2138c7.
+//
+// Solidity: event Rebalanced(address indexed keeper, uint256 timestamp)
+func (_AegisController *AegisControllerFilterer) ParseRebalanced(log types.Log) (*AegisControllerRebalanced, error) {
+	event := new(AegisControllerRebalanced)
+	if err := _AegisController.contract.UnpackLog(event, "Rebalanced", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the AegisController contract.
+type AegisControllerRoleAdminChangedIterator struct {
+	Event *AegisControllerRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerRoleAdminChanged represents a RoleAdminChanged event raised by the AegisController contract.
+type AegisControllerRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_AegisController *AegisControllerFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*AegisControllerRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerRoleAdminChangedIterator{contract: _AegisController.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_AegisController *AegisControllerFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *AegisControllerRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerRoleAdminChanged)
+				if err := _AegisController.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_AegisController *AegisControllerFilterer) ParseRoleAdminChanged(log types.Log) (*AegisControllerRoleAdminChanged, error) {
+	event := new(AegisControllerRoleAdminChanged)
+	if err := _AegisController.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the AegisController contract.
+type AegisControllerRoleGrantedIterator struct {
+	Event *AegisControllerRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerRoleGranted represents a RoleGranted event raised by the AegisController contract.
+type AegisControllerRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisController *AegisControllerFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*AegisControllerRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerRoleGrantedIterator{contract: _AegisController.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisController *AegisControllerFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *AegisControllerRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerRoleGranted)
+				if err := _AegisController.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisController *AegisControllerFilterer) ParseRoleGranted(log types.Log) (*AegisControllerRoleGranted, error) {
+	event := new(AegisControllerRoleGranted)
+	if err := _AegisController.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the AegisController contract.
+type AegisControllerRoleRevokedIterator struct {
+	Event *AegisControllerRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerRoleRevoked represents a RoleRevoked event raised by the AegisController contract.
+type AegisControllerRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisController *AegisControllerFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*AegisControllerRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerRoleRevokedIterator{contract: _AegisController.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisController *AegisControllerFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *AegisControllerRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerRoleRevoked)
+				if err := _AegisController.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisController *AegisControllerFilterer) ParseRoleRevoked(log types.Log) (*AegisControllerRoleRevoked, error) {
+	event := new(AegisControllerRoleRevoked)
+	if err := _AegisController.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerStrategyAddedIterator is returned from FilterStrategyAdded and is used to iterate over the raw logs and unpacked data for StrategyAdded events raised by the AegisController contract.
+type AegisControllerStrategyAddedIterator struct {
+	Event *AegisControllerStrategyAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerStrategyAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerStrategyAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerStrategyAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerStrategyAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerStrategyAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerStrategyAdded represents a StrategyAdded event raised by the AegisController contract.
+type AegisControllerStrategyAdded struct {
+	Strategy        common.Address
+	AllocationLimit *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterStrategyAdded is a free log retrieval operation binding the contract event 0x2f564a83158ad1831793ad3e69257b52f39ece5d49cb0d8746708ecb9ef964da.
+//
+// Solidity: event StrategyAdded(address indexed strategy, uint256 allocationLimit)
+func (_AegisController *AegisControllerFilterer) FilterStrategyAdded(opts *bind.FilterOpts, strategy []common.Address) (*AegisControllerStrategyAddedIterator, error) {
+
+	var strategyRule []interface{}
+	for _, strategyItem := range strategy {
+		strategyRule = append(strategyRule, strategyItem)
+	}
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "StrategyAdded", strategyRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerStrategyAddedIterator{contract: _AegisController.contract, event: "StrategyAdded", logs: logs, sub: sub}, nil
+}
+
+// WatchStrategyAdded is a free log subscription operation binding the contract event 0x2f564a83158ad1831793ad3e69257b52f39ece5d49cb0d8746708ecb9ef964da.
+//
+// Solidity: event StrategyAdded(address indexed strategy, uint256 allocationLimit)
+func (_AegisController *AegisControllerFilterer) WatchStrategyAdded(opts *bind.WatchOpts, sink chan<- *AegisControllerStrategyAdded, strategy []common.Address) (event.Subscription, error) {
+
+	var strategyRule []interface{}
+	for _, strategyItem := range strategy {
+		strategyRule = append(strategyRule, strategyItem)
+	}
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "StrategyAdded", strategyRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerStrategyAdded)
+				if err := _AegisController.contract.UnpackLog(event, "StrategyAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStrategyAdded is a log parse operation binding the contract event 0x2f564a83158ad1831793ad3e69257b52f39ece5d49cb0d8746708ecb9ef964da.
+//
+// Solidity: event StrategyAdded(address indexed strategy, uint256 allocationLimit)
+func (_AegisController *AegisControllerFilterer) ParseStrategyAdded(log types.Log) (*AegisControllerStrategyAdded, error) {
+	event := new(AegisControllerStrategyAdded)
+	if err := _AegisController.contract.UnpackLog(event, "StrategyAdded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerStrategyHarvestedIterator is returned from FilterStrategyHarvested and is used to iterate over the raw logs and unpacked data for StrategyHarvested events raised by the AegisController contract.
+type AegisControllerStrategyHarvestedIterator struct {
+	Event *AegisControllerStrategyHarvested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerStrategyHarvestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerStrategyHarvested)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerStrategyHarvested)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerStrategyHarvestedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerStrategyHarvestedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerStrategyHarvested represents a StrategyHarvested event raised by the AegisController contract.
+type AegisControllerStrategyHarvested struct {
+	Strategy common.Address
+	Yield    *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterStrategyHarvested is a free log retrieval operation binding the contract event 0x5ec9246ef8c762a3499bd8e11109f90e23eba10dbd10038dd97d27184a8dd971.
+//
+// Solidity: event StrategyHarvested(address indexed strategy, uint256 yield)
+func (_AegisController *AegisControllerFilterer) FilterStrategyHarvested(opts *bind.FilterOpts, strategy []common.Address) (*AegisControllerStrategyHarvestedIterator, error) {
+
+	var strategyRule []interface{}
+	for _, strategyItem := range strategy {
+		strategyRule = append(strategyRule, strategyItem)
+	}
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "StrategyHarvested", strategyRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerStrategyHarvestedIterator{contract: _AegisController.contract, event: "StrategyHarvested", logs: logs, sub: sub}, nil
+}
+
+// WatchStrategyHarvested is a free log subscription operation binding the contract event 0x5ec9246ef8c762a3499bd8e11109f90e23eba10dbd10038dd97d27184a8dd971.
+//
+// Solidity: event StrategyHarvested(address indexed strategy, uint256 yield)
+func (_AegisController *AegisControllerFilterer) WatchStrategyHarvested(opts *bind.WatchOpts, sink chan<- *AegisControllerStrategyHarvested, strategy []common.Address) (event.Subscription, error) {
+
+	var strategyRule []interface{}
+	for _, strategyItem := range strategy {
+		strategyRule = append(strategyRule, strategyItem)
+	}
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "StrategyHarvested", strategyRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerStrategyHarvested)
+				if err := _AegisController.contract.UnpackLog(event, "StrategyHarvested", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStrategyHarvested is a log parse operation binding the contract event 0x5ec9246ef8c762a3499bd8e11109f90e23eba10dbd10038dd97d27184a8dd971.
+//
+// Solidity: event StrategyHarvested(address indexed strategy, uint256 yield)
+func (_AegisController *AegisControllerFilterer) ParseStrategyHarvested(log types.Log) (*AegisControllerStrategyHarvested, error) {
+	event := new(AegisControllerStrategyHarvested)
+	if err := _AegisController.contract.UnpackLog(event, "StrategyHarvested", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerStrategyRemovedIterator is returned from FilterStrategyRemoved and is used to iterate over the raw logs and unpacked data for StrategyRemoved events raised by the AegisController contract.
+type AegisControllerStrategyRemovedIterator struct {
+	Event *AegisControllerStrategyRemoved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerStrategyRemovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerStrategyRemoved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerStrategyRemoved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerStrategyRemovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerStrategyRemovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerStrategyRemoved represents a StrategyRemoved event raised by the AegisController contract.
+type AegisControllerStrategyRemoved struct {
+	Strategy common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterStrategyRemoved is a free log retrieval operation binding the contract event 0x09a1db4b80c32706328728508c941a6b954f31eb5affd32f236c1fd405f8fea4.
+//
+// Solidity: event StrategyRemoved(address indexed strategy)
+func (_AegisController *AegisControllerFilterer) FilterStrategyRemoved(opts *bind.FilterOpts, strategy []common.Address) (*AegisControllerStrategyRemovedIterator, error) {
+
+	var strategyRule []interface{}
+	for _, strategyItem := range strategy {
+		strategyRule = append(strategyRule, strategyItem)
+	}
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "StrategyRemoved", strategyRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerStrategyRemovedIterator{contract: _AegisController.contract, event: "StrategyRemoved", logs: logs, sub: sub}, nil
+}
+
+// WatchStrategyRemoved is a free log subscription operation binding the contract event 0x09a1db4b80c32706328728508c941a6b954f31eb5affd32f236c1fd405f8fea4.
+//
+// Solidity: event StrategyRemoved(address indexed strategy)
+func (_AegisController *AegisControllerFilterer) WatchStrategyRemoved(opts *bind.WatchOpts, sink chan<- *AegisControllerStrategyRemoved, strategy []common.Address) (event.Subscription, error) {
+
+	var strategyRule []interface{}
+	for _, strategyItem := range strategy {
+		strategyRule = append(strategyRule, strategyItem)
+	}
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "StrategyRemoved", strategyRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerStrategyRemoved)
+				if err := _AegisController.contract.UnpackLog(event, "StrategyRemoved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStrategyRemoved is a log parse operation binding the contract event 0x09a1db4b80c32706328728508c941a6b954f31eb5affd32f236c1fd405f8fea4.
+//
+// Solidity: event StrategyRemoved(address indexed strategy)
+func (_AegisController *AegisControllerFilterer) ParseStrategyRemoved(log types.Log) (*AegisControllerStrategyRemoved, error) {
+	event := new(AegisControllerStrategyRemoved)
+	if err := _AegisController.contract.UnpackLog(event, "StrategyRemoved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the AegisController contract.
+type AegisControllerUnpausedIterator struct {
+	Event *AegisControllerUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerUnpaused represents a Unpaused event raised by the AegisController contract.
+type AegisControllerUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_AegisController *AegisControllerFilterer) FilterUnpaused(opts *bind.FilterOpts) (*AegisControllerUnpausedIterator, error) {
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerUnpausedIterator{contract: _AegisController.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_AegisController *AegisControllerFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *AegisControllerUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerUnpaused)
+				if err := _AegisController.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_AegisController *AegisControllerFilterer) ParseUnpaused(log types.Log) (*AegisControllerUnpaused, error) {
+	event := new(AegisControllerUnpaused)
+	if err := _AegisController.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisControllerUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the AegisController contract.
+type AegisControllerUpgradedIterator struct {
+	Event *AegisControllerUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisControllerUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisControllerUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisControllerUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisControllerUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisControllerUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisControllerUpgraded represents a Upgraded event raised by the AegisController contract.
+type AegisControllerUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_AegisController *AegisControllerFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*AegisControllerUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _AegisController.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisControllerUpgradedIterator{contract: _AegisController.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_AegisController *AegisControllerFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *AegisControllerUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _AegisController.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisControllerUpgraded)
+				if err := _AegisController.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_AegisController *AegisControllerFilterer) ParseUpgraded(log types.Log) (*AegisControllerUpgraded, error) {
+	event := new(AegisControllerUpgraded)
+	if err := _AegisController.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
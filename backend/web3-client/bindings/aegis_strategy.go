@@ -0,0 +1,451 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IAegisStrategyMetaData contains all meta data concerning the IAegisStrategy contract.
+var IAegisStrategyMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"availableLiquidity\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"currentAPY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"deposit\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"emergencyWithdraw\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"harvest\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"riskScore\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalAssets\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"withdraw\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"}]",
+}
+
+// IAegisStrategyABI is the input ABI used to generate the binding from.
+// Deprecated: Use IAegisStrategyMetaData.ABI instead.
+var IAegisStrategyABI = IAegisStrategyMetaData.ABI
+
+// IAegisStrategy is an auto generated Go binding around an Ethereum contract.
+type IAegisStrategy struct {
+	IAegisStrategyCaller     // Read-only binding to the contract
+	IAegisStrategyTransactor // Write-only binding to the contract
+	IAegisStrategyFilterer   // Log filterer for contract events
+}
+
+// IAegisStrategyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IAegisStrategyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IAegisStrategyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IAegisStrategyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IAegisStrategyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IAegisStrategyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IAegisStrategySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IAegisStrategySession struct {
+	Contract     *IAegisStrategy   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IAegisStrategyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IAegisStrategyCallerSession struct {
+	Contract *IAegisStrategyCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// IAegisStrategyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IAegisStrategyTransactorSession struct {
+	Contract     *IAegisStrategyTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// IAegisStrategyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IAegisStrategyRaw struct {
+	Contract *IAegisStrategy // Generic contract binding to access the raw methods on
+}
+
+// IAegisStrategyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IAegisStrategyCallerRaw struct {
+	Contract *IAegisStrategyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IAegisStrategyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IAegisStrategyTransactorRaw struct {
+	Contract *IAegisStrategyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIAegisStrategy creates a new instance of IAegisStrategy, bound to a specific deployed contract.
+func NewIAegisStrategy(address common.Address, backend bind.ContractBackend) (*IAegisStrategy, error) {
+	contract, err := bindIAegisStrategy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IAegisStrategy{IAegisStrategyCaller: IAegisStrategyCaller{contract: contract}, IAegisStrategyTransactor: IAegisStrategyTransactor{contract: contract}, IAegisStrategyFilterer: IAegisStrategyFilterer{contract: contract}}, nil
+}
+
+// NewIAegisStrategyCaller creates a new read-only instance of IAegisStrategy, bound to a specific deployed contract.
+func NewIAegisStrategyCaller(address common.Address, caller bind.ContractCaller) (*IAegisStrategyCaller, error) {
+	contract, err := bindIAegisStrategy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IAegisStrategyCaller{contract: contract}, nil
+}
+
+// NewIAegisStrategyTransactor creates a new write-only instance of IAegisStrategy, bound to a specific deployed contract.
+func NewIAegisStrategyTransactor(address common.Address, transactor bind.ContractTransactor) (*IAegisStrategyTransactor, error) {
+	contract, err := bindIAegisStrategy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IAegisStrategyTransactor{contract: contract}, nil
+}
+
+// NewIAegisStrategyFilterer creates a new log filterer instance of IAegisStrategy, bound to a specific deployed contract.
+func NewIAegisStrategyFilterer(address common.Address, filterer bind.ContractFilterer) (*IAegisStrategyFilterer, error) {
+	contract, err := bindIAegisStrategy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IAegisStrategyFilterer{contract: contract}, nil
+}
+
+// bindIAegisStrategy binds a generic wrapper to an already deployed contract.
+func bindIAegisStrategy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IAegisStrategyMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IAegisStrategy *IAegisStrategyRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IAegisStrategy.Contract.IAegisStrategyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IAegisStrategy *IAegisStrategyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.IAegisStrategyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IAegisStrategy *IAegisStrategyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.IAegisStrategyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IAegisStrategy *IAegisStrategyCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IAegisStrategy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IAegisStrategy *IAegisStrategyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IAegisStrategy *IAegisStrategyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_IAegisStrategy *IAegisStrategyCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IAegisStrategy.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_IAegisStrategy *IAegisStrategySession) Asset() (common.Address, error) {
+	return _IAegisStrategy.Contract.Asset(&_IAegisStrategy.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_IAegisStrategy *IAegisStrategyCallerSession) Asset() (common.Address, error) {
+	return _IAegisStrategy.Contract.Asset(&_IAegisStrategy.CallOpts)
+}
+
+// AvailableLiquidity is a free data retrieval call binding the contract method 0x74375359.
+//
+// Solidity: function availableLiquidity() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategyCaller) AvailableLiquidity(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IAegisStrategy.contract.Call(opts, &out, "availableLiquidity")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// AvailableLiquidity is a free data retrieval call binding the contract method 0x74375359.
+//
+// Solidity: function availableLiquidity() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategySession) AvailableLiquidity() (*big.Int, error) {
+	return _IAegisStrategy.Contract.AvailableLiquidity(&_IAegisStrategy.CallOpts)
+}
+
+// AvailableLiquidity is a free data retrieval call binding the contract method 0x74375359.
+//
+// Solidity: function availableLiquidity() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategyCallerSession) AvailableLiquidity() (*big.Int, error) {
+	return _IAegisStrategy.Contract.AvailableLiquidity(&_IAegisStrategy.CallOpts)
+}
+
+// CurrentAPY is a free data retrieval call binding the contract method 0x9a79d32b.
+//
+// Solidity: function currentAPY() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategyCaller) CurrentAPY(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IAegisStrategy.contract.Call(opts, &out, "currentAPY")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CurrentAPY is a free data retrieval call binding the contract method 0x9a79d32b.
+//
+// Solidity: function currentAPY() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategySession) CurrentAPY() (*big.Int, error) {
+	return _IAegisStrategy.Contract.CurrentAPY(&_IAegisStrategy.CallOpts)
+}
+
+// CurrentAPY is a free data retrieval call binding the contract method 0x9a79d32b.
+//
+// Solidity: function currentAPY() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategyCallerSession) CurrentAPY() (*big.Int, error) {
+	return _IAegisStrategy.Contract.CurrentAPY(&_IAegisStrategy.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IAegisStrategy *IAegisStrategyCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IAegisStrategy.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IAegisStrategy *IAegisStrategySession) Name() (string, error) {
+	return _IAegisStrategy.Contract.Name(&_IAegisStrategy.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IAegisStrategy *IAegisStrategyCallerSession) Name() (string, error) {
+	return _IAegisStrategy.Contract.Name(&_IAegisStrategy.CallOpts)
+}
+
+// RiskScore is a free data retrieval call binding the contract method 0xbfd02991.
+//
+// Solidity: function riskScore() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategyCaller) RiskScore(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IAegisStrategy.contract.Call(opts, &out, "riskScore")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// RiskScore is a free data retrieval call binding the contract method 0xbfd02991.
+//
+// Solidity: function riskScore() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategySession) RiskScore() (*big.Int, error) {
+	return _IAegisStrategy.Contract.RiskScore(&_IAegisStrategy.CallOpts)
+}
+
+// RiskScore is a free data retrieval call binding the contract method 0xbfd02991.
+//
+// Solidity: function riskScore() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategyCallerSession) RiskScore() (*big.Int, error) {
+	return _IAegisStrategy.Contract.RiskScore(&_IAegisStrategy.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategyCaller) TotalAssets(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IAegisStrategy.contract.Call(opts, &out, "totalAssets")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategySession) TotalAssets() (*big.Int, error) {
+	return _IAegisStrategy.Contract.TotalAssets(&_IAegisStrategy.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_IAegisStrategy *IAegisStrategyCallerSession) TotalAssets() (*big.Int, error) {
+	return _IAegisStrategy.Contract.TotalAssets(&_IAegisStrategy.CallOpts)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xb6b55f25.
+//
+// Solidity: function deposit(uint256 amount) returns(uint256)
+func (_IAegisStrategy *IAegisStrategyTransactor) Deposit(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _IAegisStrategy.contract.Transact(opts, "deposit", amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xb6b55f25.
+//
+// Solidity: function deposit(uint256 amount) returns(uint256)
+func (_IAegisStrategy *IAegisStrategySession) Deposit(amount *big.Int) (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.Deposit(&_IAegisStrategy.TransactOpts, amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xb6b55f25.
+//
+// Solidity: function deposit(uint256 amount) returns(uint256)
+func (_IAegisStrategy *IAegisStrategyTransactorSession) Deposit(amount *big.Int) (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.Deposit(&_IAegisStrategy.TransactOpts, amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0xdb2e21bc.
+//
+// Solidity: function emergencyWithdraw() returns()
+func (_IAegisStrategy *IAegisStrategyTransactor) EmergencyWithdraw(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IAegisStrategy.contract.Transact(opts, "emergencyWithdraw")
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0xdb2e21bc.
+//
+// Solidity: function emergencyWithdraw() returns()
+func (_IAegisStrategy *IAegisStrategySession) EmergencyWithdraw() (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.EmergencyWithdraw(&_IAegisStrategy.TransactOpts)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0xdb2e21bc.
+//
+// Solidity: function emergencyWithdraw() returns()
+func (_IAegisStrategy *IAegisStrategyTransactorSession) EmergencyWithdraw() (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.EmergencyWithdraw(&_IAegisStrategy.TransactOpts)
+}
+
+// Harvest is a paid mutator transaction binding the contract method 0x4641257d.
+//
+// Solidity: function harvest() returns(uint256)
+func (_IAegisStrategy *IAegisStrategyTransactor) Harvest(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IAegisStrategy.contract.Transact(opts, "harvest")
+}
+
+// Harvest is a paid mutator transaction binding the contract method 0x4641257d.
+//
+// Solidity: function harvest() returns(uint256)
+func (_IAegisStrategy *IAegisStrategySession) Harvest() (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.Harvest(&_IAegisStrategy.TransactOpts)
+}
+
+// Harvest is a paid mutator transaction binding the contract method 0x4641257d.
+//
+// Solidity: function harvest() returns(uint256)
+func (_IAegisStrategy *IAegisStrategyTransactorSession) Harvest() (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.Harvest(&_IAegisStrategy.TransactOpts)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 amount) returns(uint256)
+func (_IAegisStrategy *IAegisStrategyTransactor) Withdraw(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _IAegisStrategy.contract.Transact(opts, "withdraw", amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 amount) returns(uint256)
+func (_IAegisStrategy *IAegisStrategySession) Withdraw(amount *big.Int) (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.Withdraw(&_IAegisStrategy.TransactOpts, amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 amount) returns(uint256)
+func (_IAegisStrategy *IAegisStrategyTransactorSession) Withdraw(amount *big.Int) (*types.Transaction, error) {
+	return _IAegisStrategy.Contract.Withdraw(&_IAegisStrategy.TransactOpts, amount)
+}
@@ -0,0 +1,4367 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// AegisVaultMetaData contains all meta data concerning the AegisVault contract.
+var AegisVaultMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"BASIS_POINTS\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"CONTROLLER_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DEFAULT_ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MAX_MANAGEMENT_FEE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MAX_PERFORMANCE_FEE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"allowance\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"approve\",\"inputs\":[{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"collectFees\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"controller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"convertToAssets\",\"inputs\":[{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"convertToShares\",\"inputs\":[{\"name\":\"assets\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decreaseAllowance\",\"inputs\":[{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"subtractedValue\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"deposit\",\"inputs\":[{\"name\":\"assets\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getRoleAdmin\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"increaseAllowance\",\"inputs\":[{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"addedValue\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"asset_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"name_\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"symbol_\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"admin_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"treasury_\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"lastFeeCollection\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"managementFee\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxDeposit\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxMint\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxRedeem\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxWithdraw\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"mint\",\"inputs\":[{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"performanceFee\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"previewDeposit\",\"inputs\":[{\"name\":\"assets\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"previewMint\",\"inputs\":[{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"previewRedeem\",\"inputs\":[{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"previewWithdraw\",\"inputs\":[{\"name\":\"assets\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"proxiableUUID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"redeem\",\"inputs\":[{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setController\",\"inputs\":[{\"name\":\"newController\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setManagementFee\",\"inputs\":[{\"name\":\"newFee\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPerformanceFee\",\"inputs\":[{\"name\":\"newFee\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTreasury\",\"inputs\":[{\"name\":\"newTreasury\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"symbol\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalAssets\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transfer\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferToController\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"treasury\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"upgradeTo\",\"inputs\":[{\"name\":\"newImplementation\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"upgradeToAndCall\",\"inputs\":[{\"name\":\"newImplementation\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"withdraw\",\"inputs\":[{\"name\":\"assets\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"AdminChanged\",\"inputs\":[{\"name\":\"previousAdmin\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":false},{\"name\":\"newAdmin\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Approval\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BeaconUpgraded\",\"inputs\":[{\"name\":\"beacon\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ControllerUpdated\",\"inputs\":[{\"name\":\"oldController\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"newController\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Deposit\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"assets\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"FeesCollected\",\"inputs\":[{\"name\":\"performanceFees\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"managementFees\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint8\",\"internalType\":\"uint8\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ManagementFeeUpdated\",\"inputs\":[{\"name\":\"oldFee\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"newFee\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PerformanceFeeUpdated\",\"inputs\":[{\"name\":\"oldFee\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"newFee\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleAdminChanged\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true},{\"name\":\"previousAdminRole\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true},{\"name\":\"newAdminRole\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleGranted\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleRevoked\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\",\"indexed\":true},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TreasuryUpdated\",\"inputs\":[{\"name\":\"oldTreasury\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"newTreasury\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Upgraded\",\"inputs\":[{\"name\":\"implementation\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Withdraw\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"assets\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false}]",
+}
+
+// AegisVaultABI is the input ABI used to generate the binding from.
+// Deprecated: Use AegisVaultMetaData.ABI instead.
+var AegisVaultABI = AegisVaultMetaData.ABI
+
+// AegisVault is an auto generated Go binding around an Ethereum contract.
+type AegisVault struct {
+	AegisVaultCaller     // Read-only binding to the contract
+	AegisVaultTransactor // Write-only binding to the contract
+	AegisVaultFilterer   // Log filterer for contract events
+}
+
+// AegisVaultCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AegisVaultCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AegisVaultTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AegisVaultTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AegisVaultFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AegisVaultFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AegisVaultSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AegisVaultSession struct {
+	Contract     *AegisVault       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AegisVaultCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AegisVaultCallerSession struct {
+	Contract *AegisVaultCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// AegisVaultTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AegisVaultTransactorSession struct {
+	Contract     *AegisVaultTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// AegisVaultRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AegisVaultRaw struct {
+	Contract *AegisVault // Generic contract binding to access the raw methods on
+}
+
+// AegisVaultCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AegisVaultCallerRaw struct {
+	Contract *AegisVaultCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AegisVaultTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AegisVaultTransactorRaw struct {
+	Contract *AegisVaultTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAegisVault creates a new instance of AegisVault, bound to a specific deployed contract.
+func NewAegisVault(address common.Address, backend bind.ContractBackend) (*AegisVault, error) {
+	contract, err := bindAegisVault(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVault{AegisVaultCaller: AegisVaultCaller{contract: contract}, AegisVaultTransactor: AegisVaultTransactor{contract: contract}, AegisVaultFilterer: AegisVaultFilterer{contract: contract}}, nil
+}
+
+// NewAegisVaultCaller creates a new read-only instance of AegisVault, bound to a specific deployed contract.
+func NewAegisVaultCaller(address common.Address, caller bind.ContractCaller) (*AegisVaultCaller, error) {
+	contract, err := bindAegisVault(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultCaller{contract: contract}, nil
+}
+
+// NewAegisVaultTransactor creates a new write-only instance of AegisVault, bound to a specific deployed contract.
+func NewAegisVaultTransactor(address common.Address, transactor bind.ContractTransactor) (*AegisVaultTransactor, error) {
+	contract, err := bindAegisVault(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultTransactor{contract: contract}, nil
+}
+
+// NewAegisVaultFilterer creates a new log filterer instance of AegisVault, bound to a specific deployed contract.
+func NewAegisVaultFilterer(address common.Address, filterer bind.ContractFilterer) (*AegisVaultFilterer, error) {
+	contract, err := bindAegisVault(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultFilterer{contract: contract}, nil
+}
+
+// bindAegisVault binds a generic wrapper to an already deployed contract.
+func bindAegisVault(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AegisVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AegisVault *AegisVaultRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AegisVault.Contract.AegisVaultCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AegisVault *AegisVaultRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AegisVault.Contract.AegisVaultTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AegisVault *AegisVaultRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AegisVault.Contract.AegisVaultTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AegisVault *AegisVaultCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AegisVault.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AegisVault *AegisVaultTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AegisVault.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AegisVault *AegisVaultTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AegisVault.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_AegisVault *AegisVaultCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_AegisVault *AegisVaultSession) ADMINROLE() ([32]byte, error) {
+	return _AegisVault.Contract.ADMINROLE(&_AegisVault.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_AegisVault *AegisVaultCallerSession) ADMINROLE() ([32]byte, error) {
+	return _AegisVault.Contract.ADMINROLE(&_AegisVault.CallOpts)
+}
+
+// BASISPOINTS is a free data retrieval call binding the contract method 0xe1f1c4a7.
+//
+// Solidity: function BASIS_POINTS() view returns(uint256)
+func (_AegisVault *AegisVaultCaller) BASISPOINTS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "BASIS_POINTS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BASISPOINTS is a free data retrieval call binding the contract method 0xe1f1c4a7.
+//
+// Solidity: function BASIS_POINTS() view returns(uint256)
+func (_AegisVault *AegisVaultSession) BASISPOINTS() (*big.Int, error) {
+	return _AegisVault.Contract.BASISPOINTS(&_AegisVault.CallOpts)
+}
+
+// BASISPOINTS is a free data retrieval call binding the contract method 0xe1f1c4a7.
+//
+// Solidity: function BASIS_POINTS() view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) BASISPOINTS() (*big.Int, error) {
+	return _AegisVault.Contract.BASISPOINTS(&_AegisVault.CallOpts)
+}
+
+// CONTROLLERROLE is a free data retrieval call binding the contract method 0x092c5b3b.
+//
+// Solidity: function CONTROLLER_ROLE() view returns(bytes32)
+func (_AegisVault *AegisVaultCaller) CONTROLLERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "CONTROLLER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// CONTROLLERROLE is a free data retrieval call binding the contract method 0x092c5b3b.
+//
+// Solidity: function CONTROLLER_ROLE() view returns(bytes32)
+func (_AegisVault *AegisVaultSession) CONTROLLERROLE() ([32]byte, error) {
+	return _AegisVault.Contract.CONTROLLERROLE(&_AegisVault.CallOpts)
+}
+
+// CONTROLLERROLE is a free data retrieval call binding the contract method 0x092c5b3b.
+//
+// Solidity: function CONTROLLER_ROLE() view returns(bytes32)
+func (_AegisVault *AegisVaultCallerSession) CONTROLLERROLE() ([32]byte, error) {
+	return _AegisVault.Contract.CONTROLLERROLE(&_AegisVault.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_AegisVault *AegisVaultCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_AegisVault *AegisVaultSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _AegisVault.Contract.DEFAULTADMINROLE(&_AegisVault.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_AegisVault *AegisVaultCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _AegisVault.Contract.DEFAULTADMINROLE(&_AegisVault.CallOpts)
+}
+
+// MAXMANAGEMENTFEE is a free data retrieval call binding the contract method 0x9908840c.
+//
+// Solidity: function MAX_MANAGEMENT_FEE() view returns(uint256)
+func (_AegisVault *AegisVaultCaller) MAXMANAGEMENTFEE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "MAX_MANAGEMENT_FEE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXMANAGEMENTFEE is a free data retrieval call binding the contract method 0x9908840c.
+//
+// Solidity: function MAX_MANAGEMENT_FEE() view returns(uint256)
+func (_AegisVault *AegisVaultSession) MAXMANAGEMENTFEE() (*big.Int, error) {
+	return _AegisVault.Contract.MAXMANAGEMENTFEE(&_AegisVault.CallOpts)
+}
+
+// MAXMANAGEMENTFEE is a free data retrieval call binding the contract method 0x9908840c.
+//
+// Solidity: function MAX_MANAGEMENT_FEE() view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) MAXMANAGEMENTFEE() (*big.Int, error) {
+	return _AegisVault.Contract.MAXMANAGEMENTFEE(&_AegisVault.CallOpts)
+}
+
+// MAXPERFORMANCEFEE is a free data retrieval call binding the contract method 0xbdca9165.
+//
+// Solidity: function MAX_PERFORMANCE_FEE() view returns(uint256)
+func (_AegisVault *AegisVaultCaller) MAXPERFORMANCEFEE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "MAX_PERFORMANCE_FEE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXPERFORMANCEFEE is a free data retrieval call binding the contract method 0xbdca9165.
+//
+// Solidity: function MAX_PERFORMANCE_FEE() view returns(uint256)
+func (_AegisVault *AegisVaultSession) MAXPERFORMANCEFEE() (*big.Int, error) {
+	return _AegisVault.Contract.MAXPERFORMANCEFEE(&_AegisVault.CallOpts)
+}
+
+// MAXPERFORMANCEFEE is a free data retrieval call binding the contract method 0xbdca9165.
+//
+// Solidity: function MAX_PERFORMANCE_FEE() view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) MAXPERFORMANCEFEE() (*big.Int, error) {
+	return _AegisVault.Contract.MAXPERFORMANCEFEE(&_AegisVault.CallOpts)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_AegisVault *AegisVaultSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.Allowance(&_AegisVault.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.Allowance(&_AegisVault.CallOpts, owner, spender)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_AegisVault *AegisVaultCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_AegisVault *AegisVaultSession) Asset() (common.Address, error) {
+	return _AegisVault.Contract.Asset(&_AegisVault.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_AegisVault *AegisVaultCallerSession) Asset() (common.Address, error) {
+	return _AegisVault.Contract.Asset(&_AegisVault.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_AegisVault *AegisVaultSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.BalanceOf(&_AegisVault.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.BalanceOf(&_AegisVault.CallOpts, account)
+}
+
+// Controller is a free data retrieval call binding the contract method 0xf77c4791.
+//
+// Solidity: function controller() view returns(address)
+func (_AegisVault *AegisVaultCaller) Controller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "controller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Controller is a free data retrieval call binding the contract method 0xf77c4791.
+//
+// Solidity: function controller() view returns(address)
+func (_AegisVault *AegisVaultSession) Controller() (common.Address, error) {
+	return _AegisVault.Contract.Controller(&_AegisVault.CallOpts)
+}
+
+// Controller is a free data retrieval call binding the contract method 0xf77c4791.
+//
+// Solidity: function controller() view returns(address)
+func (_AegisVault *AegisVaultCallerSession) Controller() (common.Address, error) {
+	return _AegisVault.Contract.Controller(&_AegisVault.CallOpts)
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) ConvertToAssets(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "convertToAssets", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256)
+func (_AegisVault *AegisVaultSession) ConvertToAssets(shares *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.ConvertToAssets(&_AegisVault.CallOpts, shares)
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) ConvertToAssets(shares *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.ConvertToAssets(&_AegisVault.CallOpts, shares)
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) ConvertToShares(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "convertToShares", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256)
+func (_AegisVault *AegisVaultSession) ConvertToShares(assets *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.ConvertToShares(&_AegisVault.CallOpts, assets)
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) ConvertToShares(assets *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.ConvertToShares(&_AegisVault.CallOpts, assets)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_AegisVault *AegisVaultCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_AegisVault *AegisVaultSession) Decimals() (uint8, error) {
+	return _AegisVault.Contract.Decimals(&_AegisVault.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_AegisVault *AegisVaultCallerSession) Decimals() (uint8, error) {
+	return _AegisVault.Contract.Decimals(&_AegisVault.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_AegisVault *AegisVaultCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_AegisVault *AegisVaultSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _AegisVault.Contract.GetRoleAdmin(&_AegisVault.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_AegisVault *AegisVaultCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _AegisVault.Contract.GetRoleAdmin(&_AegisVault.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_AegisVault *AegisVaultCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_AegisVault *AegisVaultSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _AegisVault.Contract.HasRole(&_AegisVault.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_AegisVault *AegisVaultCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _AegisVault.Contract.HasRole(&_AegisVault.CallOpts, role, account)
+}
+
+// LastFeeCollection is a free data retrieval call binding the contract method 0x17a9e198.
+//
+// Solidity: function lastFeeCollection() view returns(uint256)
+func (_AegisVault *AegisVaultCaller) LastFeeCollection(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "lastFeeCollection")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastFeeCollection is a free data retrieval call binding the contract method 0x17a9e198.
+//
+// Solidity: function lastFeeCollection() view returns(uint256)
+func (_AegisVault *AegisVaultSession) LastFeeCollection() (*big.Int, error) {
+	return _AegisVault.Contract.LastFeeCollection(&_AegisVault.CallOpts)
+}
+
+// LastFeeCollection is a free data retrieval call binding the contract method 0x17a9e198.
+//
+// Solidity: function lastFeeCollection() view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) LastFeeCollection() (*big.Int, error) {
+	return _AegisVault.Contract.LastFeeCollection(&_AegisVault.CallOpts)
+}
+
+// ManagementFee is a free data retrieval call binding the contract method 0xa6f7f5d6.
+//
+// Solidity: function managementFee() view returns(uint256)
+func (_AegisVault *AegisVaultCaller) ManagementFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "managementFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ManagementFee is a free data retrieval call binding the contract method 0xa6f7f5d6.
+//
+// Solidity: function managementFee() view returns(uint256)
+func (_AegisVault *AegisVaultSession) ManagementFee() (*big.Int, error) {
+	return _AegisVault.Contract.ManagementFee(&_AegisVault.CallOpts)
+}
+
+// ManagementFee is a free data retrieval call binding the contract method 0xa6f7f5d6.
+//
+// Solidity: function managementFee() view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) ManagementFee() (*big.Int, error) {
+	return _AegisVault.Contract.ManagementFee(&_AegisVault.CallOpts)
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address ) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) MaxDeposit(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "maxDeposit", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address ) view returns(uint256)
+func (_AegisVault *AegisVaultSession) MaxDeposit(arg0 common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.MaxDeposit(&_AegisVault.CallOpts, arg0)
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address ) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) MaxDeposit(arg0 common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.MaxDeposit(&_AegisVault.CallOpts, arg0)
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address ) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) MaxMint(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "maxMint", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address ) view returns(uint256)
+func (_AegisVault *AegisVaultSession) MaxMint(arg0 common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.MaxMint(&_AegisVault.CallOpts, arg0)
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address ) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) MaxMint(arg0 common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.MaxMint(&_AegisVault.CallOpts, arg0)
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) MaxRedeem(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "maxRedeem", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256)
+func (_AegisVault *AegisVaultSession) MaxRedeem(owner common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.MaxRedeem(&_AegisVault.CallOpts, owner)
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) MaxRedeem(owner common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.MaxRedeem(&_AegisVault.CallOpts, owner)
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) MaxWithdraw(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "maxWithdraw", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256)
+func (_AegisVault *AegisVaultSession) MaxWithdraw(owner common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.MaxWithdraw(&_AegisVault.CallOpts, owner)
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) MaxWithdraw(owner common.Address) (*big.Int, error) {
+	return _AegisVault.Contract.MaxWithdraw(&_AegisVault.CallOpts, owner)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_AegisVault *AegisVaultCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_AegisVault *AegisVaultSession) Name() (string, error) {
+	return _AegisVault.Contract.Name(&_AegisVault.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_AegisVault *AegisVaultCallerSession) Name() (string, error) {
+	return _AegisVault.Contract.Name(&_AegisVault.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_AegisVault *AegisVaultCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_AegisVault *AegisVaultSession) Paused() (bool, error) {
+	return _AegisVault.Contract.Paused(&_AegisVault.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_AegisVault *AegisVaultCallerSession) Paused() (bool, error) {
+	return _AegisVault.Contract.Paused(&_AegisVault.CallOpts)
+}
+
+// PerformanceFee is a free data retrieval call binding the contract method 0x87788782.
+//
+// Solidity: function performanceFee() view returns(uint256)
+func (_AegisVault *AegisVaultCaller) PerformanceFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "performanceFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PerformanceFee is a free data retrieval call binding the contract method 0x87788782.
+//
+// Solidity: function performanceFee() view returns(uint256)
+func (_AegisVault *AegisVaultSession) PerformanceFee() (*big.Int, error) {
+	return _AegisVault.Contract.PerformanceFee(&_AegisVault.CallOpts)
+}
+
+// PerformanceFee is a free data retrieval call binding the contract method 0x87788782.
+//
+// Solidity: function performanceFee() view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) PerformanceFee() (*big.Int, error) {
+	return _AegisVault.Contract.PerformanceFee(&_AegisVault.CallOpts)
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) PreviewDeposit(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "previewDeposit", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256)
+func (_AegisVault *AegisVaultSession) PreviewDeposit(assets *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.PreviewDeposit(&_AegisVault.CallOpts, assets)
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) PreviewDeposit(assets *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.PreviewDeposit(&_AegisVault.CallOpts, assets)
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) PreviewMint(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "previewMint", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256)
+func (_AegisVault *AegisVaultSession) PreviewMint(shares *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.PreviewMint(&_AegisVault.CallOpts, shares)
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) PreviewMint(shares *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.PreviewMint(&_AegisVault.CallOpts, shares)
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) PreviewRedeem(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "previewRedeem", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256)
+func (_AegisVault *AegisVaultSession) PreviewRedeem(shares *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.PreviewRedeem(&_AegisVault.CallOpts, shares)
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) PreviewRedeem(shares *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.PreviewRedeem(&_AegisVault.CallOpts, shares)
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256)
+func (_AegisVault *AegisVaultCaller) PreviewWithdraw(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "previewWithdraw", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256)
+func (_AegisVault *AegisVaultSession) PreviewWithdraw(assets *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.PreviewWithdraw(&_AegisVault.CallOpts, assets)
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) PreviewWithdraw(assets *big.Int) (*big.Int, error) {
+	return _AegisVault.Contract.PreviewWithdraw(&_AegisVault.CallOpts, assets)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_AegisVault *AegisVaultCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_AegisVault *AegisVaultSession) ProxiableUUID() ([32]byte, error) {
+	return _AegisVault.Contract.ProxiableUUID(&_AegisVault.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_AegisVault *AegisVaultCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _AegisVault.Contract.ProxiableUUID(&_AegisVault.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_AegisVault *AegisVaultCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_AegisVault *AegisVaultSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _AegisVault.Contract.SupportsInterface(&_AegisVault.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_AegisVault *AegisVaultCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _AegisVault.Contract.SupportsInterface(&_AegisVault.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_AegisVault *AegisVaultCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_AegisVault *AegisVaultSession) Symbol() (string, error) {
+	return _AegisVault.Contract.Symbol(&_AegisVault.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_AegisVault *AegisVaultCallerSession) Symbol() (string, error) {
+	return _AegisVault.Contract.Symbol(&_AegisVault.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_AegisVault *AegisVaultCaller) TotalAssets(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "totalAssets")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_AegisVault *AegisVaultSession) TotalAssets() (*big.Int, error) {
+	return _AegisVault.Contract.TotalAssets(&_AegisVault.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) TotalAssets() (*big.Int, error) {
+	return _AegisVault.Contract.TotalAssets(&_AegisVault.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_AegisVault *AegisVaultCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_AegisVault *AegisVaultSession) TotalSupply() (*big.Int, error) {
+	return _AegisVault.Contract.TotalSupply(&_AegisVault.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_AegisVault *AegisVaultCallerSession) TotalSupply() (*big.Int, error) {
+	return _AegisVault.Contract.TotalSupply(&_AegisVault.CallOpts)
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_AegisVault *AegisVaultCaller) Treasury(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AegisVault.contract.Call(opts, &out, "treasury")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_AegisVault *AegisVaultSession) Treasury() (common.Address, error) {
+	return _AegisVault.Contract.Treasury(&_AegisVault.CallOpts)
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_AegisVault *AegisVaultCallerSession) Treasury() (common.Address, error) {
+	return _AegisVault.Contract.Treasury(&_AegisVault.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_AegisVault *AegisVaultTransactor) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "approve", spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_AegisVault *AegisVaultSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.Approve(&_AegisVault.TransactOpts, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_AegisVault *AegisVaultTransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.Approve(&_AegisVault.TransactOpts, spender, amount)
+}
+
+// CollectFees is a paid mutator transaction binding the contract method 0xc8796572.
+//
+// Solidity: function collectFees() returns()
+func (_AegisVault *AegisVaultTransactor) CollectFees(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "collectFees")
+}
+
+// CollectFees is a paid mutator transaction binding the contract method 0xc8796572.
+//
+// Solidity: function collectFees() returns()
+func (_AegisVault *AegisVaultSession) CollectFees() (*types.Transaction, error) {
+	return _AegisVault.Contract.CollectFees(&_AegisVault.TransactOpts)
+}
+
+// CollectFees is a paid mutator transaction binding the contract method 0xc8796572.
+//
+// Solidity: function collectFees() returns()
+func (_AegisVault *AegisVaultTransactorSession) CollectFees() (*types.Transaction, error) {
+	return _AegisVault.Contract.CollectFees(&_AegisVault.TransactOpts)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_AegisVault *AegisVaultTransactor) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "decreaseAllowance", spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_AegisVault *AegisVaultSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.DecreaseAllowance(&_AegisVault.TransactOpts, spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_AegisVault *AegisVaultTransactorSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.DecreaseAllowance(&_AegisVault.TransactOpts, spender, subtractedValue)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256)
+func (_AegisVault *AegisVaultTransactor) Deposit(opts *bind.TransactOpts, assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "deposit", assets, receiver)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256)
+func (_AegisVault *AegisVaultSession) Deposit(assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.Deposit(&_AegisVault.TransactOpts, assets, receiver)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256)
+func (_AegisVault *AegisVaultTransactorSession) Deposit(assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.Deposit(&_AegisVault.TransactOpts, assets, receiver)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_AegisVault *AegisVaultTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_AegisVault *AegisVaultSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.GrantRole(&_AegisVault.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_AegisVault *AegisVaultTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.GrantRole(&_AegisVault.TransactOpts, role, account)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_AegisVault *AegisVaultTransactor) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "increaseAllowance", spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_AegisVault *AegisVaultSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.IncreaseAllowance(&_AegisVault.TransactOpts, spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_AegisVault *AegisVaultTransactorSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.IncreaseAllowance(&_AegisVault.TransactOpts, spender, addedValue)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x6cf1dbed.
+//
+// Solidity: function initialize(address asset_, string name_, string symbol_, address admin_, address treasury_) returns()
+func (_AegisVault *AegisVaultTransactor) Initialize(opts *bind.TransactOpts, asset_ common.Address, name_ string, symbol_ string, admin_ common.Address, treasury_ common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "initialize", asset_, name_, symbol_, admin_, treasury_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x6cf1dbed.
+//
+// Solidity: function initialize(address asset_, string name_, string symbol_, address admin_, address treasury_) returns()
+func (_AegisVault *AegisVaultSession) Initialize(asset_ common.Address, name_ string, symbol_ string, admin_ common.Address, treasury_ common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.Initialize(&_AegisVault.TransactOpts, asset_, name_, symbol_, admin_, treasury_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x6cf1dbed.
+//
+// Solidity: function initialize(address asset_, string name_, string symbol_, address admin_, address treasury_) returns()
+func (_AegisVault *AegisVaultTransactorSession) Initialize(asset_ common.Address, name_ string, symbol_ string, admin_ common.Address, treasury_ common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.Initialize(&_AegisVault.TransactOpts, asset_, name_, symbol_, admin_, treasury_)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256)
+func (_AegisVault *AegisVaultTransactor) Mint(opts *bind.TransactOpts, shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "mint", shares, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256)
+func (_AegisVault *AegisVaultSession) Mint(shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.Mint(&_AegisVault.TransactOpts, shares, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256)
+func (_AegisVault *AegisVaultTransactorSession) Mint(shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.Mint(&_AegisVault.TransactOpts, shares, receiver)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_AegisVault *AegisVaultTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_AegisVault *AegisVaultSession) Pause() (*types.Transaction, error) {
+	return _AegisVault.Contract.Pause(&_AegisVault.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_AegisVault *AegisVaultTransactorSession) Pause() (*types.Transaction, error) {
+	return _AegisVault.Contract.Pause(&_AegisVault.TransactOpts)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256)
+func (_AegisVault *AegisVaultTransactor) Redeem(opts *bind.TransactOpts, shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "redeem", shares, receiver, owner)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256)
+func (_AegisVault *AegisVaultSession) Redeem(shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.Redeem(&_AegisVault.TransactOpts, shares, receiver, owner)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256)
+func (_AegisVault *AegisVaultTransactorSession) Redeem(shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.Redeem(&_AegisVault.TransactOpts, shares, receiver, owner)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_AegisVault *AegisVaultTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "renounceRole", role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_AegisVault *AegisVaultSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.RenounceRole(&_AegisVault.TransactOpts, role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_AegisVault *AegisVaultTransactorSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.RenounceRole(&_AegisVault.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_AegisVault *AegisVaultTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_AegisVault *AegisVaultSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.RevokeRole(&_AegisVault.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_AegisVault *AegisVaultTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.RevokeRole(&_AegisVault.TransactOpts, role, account)
+}
+
+// SetController is a paid mutator transaction binding the contract method 0x92eefe9b.
+//
+// Solidity: function setController(address newController) returns()
+func (_AegisVault *AegisVaultTransactor) SetController(opts *bind.TransactOpts, newController common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "setController", newController)
+}
+
+// SetController is a paid mutator transaction binding the contract method 0x92eefe9b.
+//
+// Solidity: function setController(address newController) returns()
+func (_AegisVault *AegisVaultSession) SetController(newController common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.SetController(&_AegisVault.TransactOpts, newController)
+}
+
+// SetController is a paid mutator transaction binding the contract method 0x92eefe9b.
+//
+// Solidity: function setController(address newController) returns()
+func (_AegisVault *AegisVaultTransactorSession) SetController(newController common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.SetController(&_AegisVault.TransactOpts, newController)
+}
+
+// SetManagementFee is a paid mutator transaction binding the contract method 0xfe56e232.
+//
+// Solidity: function setManagementFee(uint256 newFee) returns()
+func (_AegisVault *AegisVaultTransactor) SetManagementFee(opts *bind.TransactOpts, newFee *big.Int) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "setManagementFee", newFee)
+}
+
+// SetManagementFee is a paid mutator transaction binding the contract method 0xfe56e232.
+//
+// Solidity: function setManagementFee(uint256 newFee) returns()
+func (_AegisVault *AegisVaultSession) SetManagementFee(newFee *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.SetManagementFee(&_AegisVault.TransactOpts, newFee)
+}
+
+// SetManagementFee is a paid mutator transaction binding the contract method 0xfe56e232.
+//
+// Solidity: function setManagementFee(uint256 newFee) returns()
+func (_AegisVault *AegisVaultTransactorSession) SetManagementFee(newFee *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.SetManagementFee(&_AegisVault.TransactOpts, newFee)
+}
+
+// SetPerformanceFee is a paid mutator transaction binding the contract method 0x70897b23.
+//
+// Solidity: function setPerformanceFee(uint256 newFee) returns()
+func (_AegisVault *AegisVaultTransactor) SetPerformanceFee(opts *bind.TransactOpts, newFee *big.Int) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "setPerformanceFee", newFee)
+}
+
+// SetPerformanceFee is a paid mutator transaction binding the contract method 0x70897b23.
+//
+// Solidity: function setPerformanceFee(uint256 newFee) returns()
+func (_AegisVault *AegisVaultSession) SetPerformanceFee(newFee *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.SetPerformanceFee(&_AegisVault.TransactOpts, newFee)
+}
+
+// SetPerformanceFee is a paid mutator transaction binding the contract method 0x70897b23.
+//
+// Solidity: function setPerformanceFee(uint256 newFee) returns()
+func (_AegisVault *AegisVaultTransactorSession) SetPerformanceFee(newFee *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.SetPerformanceFee(&_AegisVault.TransactOpts, newFee)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_AegisVault *AegisVaultTransactor) SetTreasury(opts *bind.TransactOpts, newTreasury common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "setTreasury", newTreasury)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_AegisVault *AegisVaultSession) SetTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.SetTreasury(&_AegisVault.TransactOpts, newTreasury)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_AegisVault *AegisVaultTransactorSession) SetTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.SetTreasury(&_AegisVault.TransactOpts, newTreasury)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_AegisVault *AegisVaultTransactor) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "transfer", to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_AegisVault *AegisVaultSession) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.Transfer(&_AegisVault.TransactOpts, to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_AegisVault *AegisVaultTransactorSession) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.Transfer(&_AegisVault.TransactOpts, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_AegisVault *AegisVaultTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "transferFrom", from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_AegisVault *AegisVaultSession) TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.TransferFrom(&_AegisVault.TransactOpts, from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_AegisVault *AegisVaultTransactorSession) TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.TransferFrom(&_AegisVault.TransactOpts, from, to, amount)
+}
+
+// TransferToController is a paid mutator transaction binding the contract method 0x58aaa0a2.
+//
+// Solidity: function transferToController(uint256 amount) returns()
+func (_AegisVault *AegisVaultTransactor) TransferToController(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "transferToController", amount)
+}
+
+// TransferToController is a paid mutator transaction binding the contract method 0x58aaa0a2.
+//
+// Solidity: function transferToController(uint256 amount) returns()
+func (_AegisVault *AegisVaultSession) TransferToController(amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.TransferToController(&_AegisVault.TransactOpts, amount)
+}
+
+// TransferToController is a paid mutator transaction binding the contract method 0x58aaa0a2.
+//
+// Solidity: function transferToController(uint256 amount) returns()
+func (_AegisVault *AegisVaultTransactorSession) TransferToController(amount *big.Int) (*types.Transaction, error) {
+	return _AegisVault.Contract.TransferToController(&_AegisVault.TransactOpts, amount)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_AegisVault *AegisVaultTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_AegisVault *AegisVaultSession) Unpause() (*types.Transaction, error) {
+	return _AegisVault.Contract.Unpause(&_AegisVault.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_AegisVault *AegisVaultTransactorSession) Unpause() (*types.Transaction, error) {
+	return _AegisVault.Contract.Unpause(&_AegisVault.TransactOpts)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_AegisVault *AegisVaultTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_AegisVault *AegisVaultSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.UpgradeTo(&_AegisVault.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_AegisVault *AegisVaultTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.UpgradeTo(&_AegisVault.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_AegisVault *AegisVaultTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_AegisVault *AegisVaultSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _AegisVault.Contract.UpgradeToAndCall(&_AegisVault.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_AegisVault *AegisVaultTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _AegisVault.Contract.UpgradeToAndCall(&_AegisVault.TransactOpts, newImplementation, data)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256)
+func (_AegisVault *AegisVaultTransactor) Withdraw(opts *bind.TransactOpts, assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _AegisVault.contract.Transact(opts, "withdraw", assets, receiver, owner)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256)
+func (_AegisVault *AegisVaultSession) Withdraw(assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.Withdraw(&_AegisVault.TransactOpts, assets, receiver, owner)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256)
+func (_AegisVault *AegisVaultTransactorSession) Withdraw(assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _AegisVault.Contract.Withdraw(&_AegisVault.TransactOpts, assets, receiver, owner)
+}
+
+// AegisVaultAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the AegisVault contract.
+type AegisVaultAdminChangedIterator struct {
+	Event *AegisVaultAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultAdminChanged represents a AdminChanged event raised by the AegisVault contract.
+type AegisVaultAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_AegisVault *AegisVaultFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*AegisVaultAdminChangedIterator, error) {
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultAdminChangedIterator{contract: _AegisVault.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_AegisVault *AegisVaultFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *AegisVaultAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultAdminChanged)
+				if err := _AegisVault.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_AegisVault *AegisVaultFilterer) ParseAdminChanged(log types.Log) (*AegisVaultAdminChanged, error) {
+	event := new(AegisVaultAdminChanged)
+	if err := _AegisVault.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the AegisVault contract.
+type AegisVaultApprovalIterator struct {
+	Event *AegisVaultApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultApproval represents a Approval event raised by the AegisVault contract.
+type AegisVaultApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_AegisVault *AegisVaultFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*AegisVaultApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultApprovalIterator{contract: _AegisVault.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_AegisVault *AegisVaultFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *AegisVaultApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultApproval)
+				if err := _AegisVault.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_AegisVault *AegisVaultFilterer) ParseApproval(log types.Log) (*AegisVaultApproval, error) {
+	event := new(AegisVaultApproval)
+	if err := _AegisVault.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the AegisVault contract.
+type AegisVaultBeaconUpgradedIterator struct {
+	Event *AegisVaultBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultBeaconUpgraded represents a BeaconUpgraded event raised by the AegisVault contract.
+type AegisVaultBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_AegisVault *AegisVaultFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*AegisVaultBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultBeaconUpgradedIterator{contract: _AegisVault.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_AegisVault *AegisVaultFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *AegisVaultBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultBeaconUpgraded)
+				if err := _AegisVault.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_AegisVault *AegisVaultFilterer) ParseBeaconUpgraded(log types.Log) (*AegisVaultBeaconUpgraded, error) {
+	event := new(AegisVaultBeaconUpgraded)
+	if err := _AegisVault.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultControllerUpdatedIterator is returned from FilterControllerUpdated and is used to iterate over the raw logs and unpacked data for ControllerUpdated events raised by the AegisVault contract.
+type AegisVaultControllerUpdatedIterator struct {
+	Event *AegisVaultControllerUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultControllerUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultControllerUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultControllerUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultControllerUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultControllerUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultControllerUpdated represents a ControllerUpdated event raised by the AegisVault contract.
+type AegisVaultControllerUpdated struct {
+	OldController common.Address
+	NewController common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterControllerUpdated is a free log retrieval operation binding the contract event 0x1c87e2bbc4e5fa5d7f6f8c44d66cb241dff224b8602eb5435ca2076d2a5c6fc2.
+//
+// Solidity: event ControllerUpdated(address indexed oldController, address indexed newController)
+func (_AegisVault *AegisVaultFilterer) FilterControllerUpdated(opts *bind.FilterOpts, oldController []common.Address, newController []common.Address) (*AegisVaultControllerUpdatedIterator, error) {
+
+	var oldControllerRule []interface{}
+	for _, oldControllerItem := range oldController {
+		oldControllerRule = append(oldControllerRule, oldControllerItem)
+	}
+	var newControllerRule []interface{}
+	for _, newControllerItem := range newController {
+		newControllerRule = append(newControllerRule, newControllerItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "ControllerUpdated", oldControllerRule, newControllerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultControllerUpdatedIterator{contract: _AegisVault.contract, event: "ControllerUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchControllerUpdated is a free log subscription operation binding the contract event 0x1c87e2bbc4e5fa5d7f6f8c44d66cb241dff224b8602eb5435ca2076d2a5c6fc2.
+//
+// Solidity: event ControllerUpdated(address indexed oldController, address indexed newController)
+func (_AegisVault *AegisVaultFilterer) WatchControllerUpdated(opts *bind.WatchOpts, sink chan<- *AegisVaultControllerUpdated, oldController []common.Address, newController []common.Address) (event.Subscription, error) {
+
+	var oldControllerRule []interface{}
+	for _, oldControllerItem := range oldController {
+		oldControllerRule = append(oldControllerRule, oldControllerItem)
+	}
+	var newControllerRule []interface{}
+	for _, newControllerItem := range newController {
+		newControllerRule = append(newControllerRule, newControllerItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "ControllerUpdated", oldControllerRule, newControllerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultControllerUpdated)
+				if err := _AegisVault.contract.UnpackLog(event, "ControllerUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseControllerUpdated is a log parse operation binding the contract event 0x1c87e2bbc4e5fa5d7f6f8c44d66cb241dff224b8602eb5435ca2076d2a5c6fc2.
+//
+// Solidity: event ControllerUpdated(address indexed oldController, address indexed newController)
+func (_AegisVault *AegisVaultFilterer) ParseControllerUpdated(log types.Log) (*AegisVaultControllerUpdated, error) {
+	event := new(AegisVaultControllerUpdated)
+	if err := _AegisVault.contract.UnpackLog(event, "ControllerUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the AegisVault contract.
+type AegisVaultDepositIterator struct {
+	Event *AegisVaultDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultDeposit represents a Deposit event raised by the AegisVault contract.
+type AegisVaultDeposit struct {
+	Sender common.Address
+	Owner  common.Address
+	Assets *big.Int
+	Shares *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_AegisVault *AegisVaultFilterer) FilterDeposit(opts *bind.FilterOpts, sender []common.Address, owner []common.Address) (*AegisVaultDepositIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "Deposit", senderRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultDepositIterator{contract: _AegisVault.contract, event: "Deposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_AegisVault *AegisVaultFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *AegisVaultDeposit, sender []common.Address, owner []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "Deposit", senderRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultDeposit)
+				if err := _AegisVault.contract.UnpackLog(event, "Deposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposit is a log parse operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_AegisVault *AegisVaultFilterer) ParseDeposit(log types.Log) (*AegisVaultDeposit, error) {
+	event := new(AegisVaultDeposit)
+	if err := _AegisVault.contract.UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultFeesCollectedIterator is returned from FilterFeesCollected and is used to iterate over the raw logs and unpacked data for FeesCollected events raised by the AegisVault contract.
+type AegisVaultFeesCollectedIterator struct {
+	Event *AegisVaultFeesCollected // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultFeesCollectedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultFeesCollected)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultFeesCollected)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultFeesCollectedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultFeesCollectedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultFeesCollected represents a FeesCollected event raised by the AegisVault contract.
+type AegisVaultFeesCollected struct {
+	PerformanceFees *big.Int
+	ManagementFees  *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeesCollected is a free log retrieval operation binding the contract event 0x49d512bf9cb224241c05691e73eb9fab078cf350c7dbcbcf66788f1fc0cc8b0b.
+//
+// Solidity: event FeesCollected(uint256 performanceFees, uint256 managementFees)
+func (_AegisVault *AegisVaultFilterer) FilterFeesCollected(opts *bind.FilterOpts) (*AegisVaultFeesCollectedIterator, error) {
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "FeesCollected")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultFeesCollectedIterator{contract: _AegisVault.contract, event: "FeesCollected", logs: logs, sub: sub}, nil
+}
+
+// WatchFeesCollected is a free log subscription operation binding the contract event 0x49d512bf9cb224241c05691e73eb9fab078cf350c7dbcbcf66788f1fc0cc8b0b.
+//
+// Solidity: event FeesCollected(uint256 performanceFees, uint256 managementFees)
+func (_AegisVault *AegisVaultFilterer) WatchFeesCollected(opts *bind.WatchOpts, sink chan<- *AegisVaultFeesCollected) (event.Subscription, error) {
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "FeesCollected")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultFeesCollected)
+				if err := _AegisVault.contract.UnpackLog(event, "FeesCollected", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeesCollected is a log parse operation binding the contract event 0x49d512bf9cb224241c05691e73eb9fab078cf350c7dbcbcf66788f1fc0cc8b0b.
+//
+// Solidity: event FeesCollected(uint256 performanceFees, uint256 managementFees)
+func (_AegisVault *AegisVaultFilterer) ParseFeesCollected(log types.Log) (*AegisVaultFeesCollected, error) {
+	event := new(AegisVaultFeesCollected)
+	if err := _AegisVault.contract.UnpackLog(event, "FeesCollected", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the AegisVault contract.
+type AegisVaultInitializedIterator struct {
+	Event *AegisVaultInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultInitialized represents a Initialized event raised by the AegisVault contract.
+type AegisVaultInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_AegisVault *AegisVaultFilterer) FilterInitialized(opts *bind.FilterOpts) (*AegisVaultInitializedIterator, error) {
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultInitializedIterator{contract: _AegisVault.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_AegisVault *AegisVaultFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *AegisVaultInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultInitialized)
+				if err := _AegisVault.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_AegisVault *AegisVaultFilterer) ParseInitialized(log types.Log) (*AegisVaultInitialized, error) {
+	event := new(AegisVaultInitialized)
+	if err := _AegisVault.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultManagementFeeUpdatedIterator is returned from FilterManagementFeeUpdated and is used to iterate over the raw logs and unpacked data for ManagementFeeUpdated events raised by the AegisVault contract.
+type AegisVaultManagementFeeUpdatedIterator struct {
+	Event *AegisVaultManagementFeeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultManagementFeeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultManagementFeeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultManagementFeeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultManagementFeeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultManagementFeeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultManagementFeeUpdated represents a ManagementFeeUpdated event raised by the AegisVault contract.
+type AegisVaultManagementFeeUpdated struct {
+	OldFee *big.Int
+	NewFee *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterManagementFeeUpdated is a free log retrieval operation binding the contract event 0xf5ff4786e844406502466108c183ca5ff947880b3ba08b1500eea9c29d12030d.
+//
+// Solidity: event ManagementFeeUpdated(uint256 oldFee, uint256 newFee)
+func (_AegisVault *AegisVaultFilterer) FilterManagementFeeUpdated(opts *bind.FilterOpts) (*AegisVaultManagementFeeUpdatedIterator, error) {
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "ManagementFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultManagementFeeUpdatedIterator{contract: _AegisVault.contract, event: "ManagementFeeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchManagementFeeUpdated is a free log subscription operation binding the contract event 0xf5ff4786e844406502466108c183ca5ff947880b3ba08b1500eea9c29d12030d.
+//
+// Solidity: event ManagementFeeUpdated(uint256 oldFee, uint256 newFee)
+func (_AegisVault *AegisVaultFilterer) WatchManagementFeeUpdated(opts *bind.WatchOpts, sink chan<- *AegisVaultManagementFeeUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "ManagementFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultManagementFeeUpdated)
+				if err := _AegisVault.contract.UnpackLog(event, "ManagementFeeUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseManagementFeeUpdated is a log parse operation binding the contract event 0xf5ff4786e844406502466108c183ca5ff947880b3ba08b1500eea9c29d12030d.
+//
+// Solidity: event ManagementFeeUpdated(uint256 oldFee, uint256 newFee)
+func (_AegisVault *AegisVaultFilterer) ParseManagementFeeUpdated(log types.Log) (*AegisVaultManagementFeeUpdated, error) {
+	event := new(AegisVaultManagementFeeUpdated)
+	if err := _AegisVault.contract.UnpackLog(event, "ManagementFeeUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the AegisVault contract.
+type AegisVaultPausedIterator struct {
+	Event *AegisVaultPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultPaused represents a Paused event raised by the AegisVault contract.
+type AegisVaultPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_AegisVault *AegisVaultFilterer) FilterPaused(opts *bind.FilterOpts) (*AegisVaultPausedIterator, error) {
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultPausedIterator{contract: _AegisVault.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_AegisVault *AegisVaultFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *AegisVaultPaused) (event.Subscription, error) {
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultPaused)
+				if err := _AegisVault.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_AegisVault *AegisVaultFilterer) ParsePaused(log types.Log) (*AegisVaultPaused, error) {
+	event := new(AegisVaultPaused)
+	if err := _AegisVault.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultPerformanceFeeUpdatedIterator is returned from FilterPerformanceFeeUpdated and is used to iterate over the raw logs and unpacked data for PerformanceFeeUpdated events raised by the AegisVault contract.
+type AegisVaultPerformanceFeeUpdatedIterator struct {
+	Event *AegisVaultPerformanceFeeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultPerformanceFeeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultPerformanceFeeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultPerformanceFeeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultPerformanceFeeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultPerformanceFeeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultPerformanceFeeUpdated represents a PerformanceFeeUpdated event raised by the AegisVault contract.
+type AegisVaultPerformanceFeeUpdated struct {
+	OldFee *big.Int
+	NewFee *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterPerformanceFeeUpdated is a free log retrieval operation binding the contract event 0x607b1c943753982194530bf7133a5972ea2626e028005410efa54ab20035caf8.
+//
+// Solidity: event PerformanceFeeUpdated(uint256 oldFee, uint256 newFee)
+func (_AegisVault *AegisVaultFilterer) FilterPerformanceFeeUpdated(opts *bind.FilterOpts) (*AegisVaultPerformanceFeeUpdatedIterator, error) {
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "PerformanceFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultPerformanceFeeUpdatedIterator{contract: _AegisVault.contract, event: "PerformanceFeeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchPerformanceFeeUpdated is a free log subscription operation binding the contract event 0x607b1c943753982194530bf7133a5972ea2626e028005410efa54ab20035caf8.
+//
+// Solidity: event PerformanceFeeUpdated(uint256 oldFee, uint256 newFee)
+func (_AegisVault *AegisVaultFilterer) WatchPerformanceFeeUpdated(opts *bind.WatchOpts, sink chan<- *AegisVaultPerformanceFeeUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "PerformanceFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultPerformanceFeeUpdated)
+				if err := _AegisVault.contract.UnpackLog(event, "PerformanceFeeUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePerformanceFeeUpdated is a log parse operation binding the contract event 0x607b1c943753982194530bf7133a5972ea2626e028005410efa54ab20035caf8.
+//
+// Solidity: event PerformanceFeeUpdated(uint256 oldFee, uint256 newFee)
+func (_AegisVault *AegisVaultFilterer) ParsePerformanceFeeUpdated(log types.Log) (*AegisVaultPerformanceFeeUpdated, error) {
+	event := new(AegisVaultPerformanceFeeUpdated)
+	if err := _AegisVault.contract.UnpackLog(event, "PerformanceFeeUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the AegisVault contract.
+type AegisVaultRoleAdminChangedIterator struct {
+	Event *AegisVaultRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultRoleAdminChanged represents a RoleAdminChanged event raised by the AegisVault contract.
+type AegisVaultRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_AegisVault *AegisVaultFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*AegisVaultRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultRoleAdminChangedIterator{contract: _AegisVault.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_AegisVault *AegisVaultFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *AegisVaultRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultRoleAdminChanged)
+				if err := _AegisVault.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_AegisVault *AegisVaultFilterer) ParseRoleAdminChanged(log types.Log) (*AegisVaultRoleAdminChanged, error) {
+	event := new(AegisVaultRoleAdminChanged)
+	if err := _AegisVault.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the AegisVault contract.
+type AegisVaultRoleGrantedIterator struct {
+	Event *AegisVaultRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultRoleGranted represents a RoleGranted event raised by the AegisVault contract.
+type AegisVaultRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisVault *AegisVaultFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*AegisVaultRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultRoleGrantedIterator{contract: _AegisVault.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisVault *AegisVaultFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *AegisVaultRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultRoleGranted)
+				if err := _AegisVault.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisVault *AegisVaultFilterer) ParseRoleGranted(log types.Log) (*AegisVaultRoleGranted, error) {
+	event := new(AegisVaultRoleGranted)
+	if err := _AegisVault.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the AegisVault contract.
+type AegisVaultRoleRevokedIterator struct {
+	Event *AegisVaultRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultRoleRevoked represents a RoleRevoked event raised by the AegisVault contract.
+type AegisVaultRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisVault *AegisVaultFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*AegisVaultRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultRoleRevokedIterator{contract: _AegisVault.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisVault *AegisVaultFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *AegisVaultRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultRoleRevoked)
+				if err := _AegisVault.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_AegisVault *AegisVaultFilterer) ParseRoleRevoked(log types.Log) (*AegisVaultRoleRevoked, error) {
+	event := new(AegisVaultRoleRevoked)
+	if err := _AegisVault.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the AegisVault contract.
+type AegisVaultTransferIterator struct {
+	Event *AegisVaultTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultTransfer represents a Transfer event raised by the AegisVault contract.
+type AegisVaultTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_AegisVault *AegisVaultFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*AegisVaultTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultTransferIterator{contract: _AegisVault.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_AegisVault *AegisVaultFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *AegisVaultTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultTransfer)
+				if err := _AegisVault.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_AegisVault *AegisVaultFilterer) ParseTransfer(log types.Log) (*AegisVaultTransfer, error) {
+	event := new(AegisVaultTransfer)
+	if err := _AegisVault.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultTreasuryUpdatedIterator is returned from FilterTreasuryUpdated and is used to iterate over the raw logs and unpacked data for TreasuryUpdated events raised by the AegisVault contract.
+type AegisVaultTreasuryUpdatedIterator struct {
+	Event *AegisVaultTreasuryUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultTreasuryUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultTreasuryUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultTreasuryUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultTreasuryUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultTreasuryUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultTreasuryUpdated represents a TreasuryUpdated event raised by the AegisVault contract.
+type AegisVaultTreasuryUpdated struct {
+	OldTreasury common.Address
+	NewTreasury common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterTreasuryUpdated is a free log retrieval operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address indexed oldTreasury, address indexed newTreasury)
+func (_AegisVault *AegisVaultFilterer) FilterTreasuryUpdated(opts *bind.FilterOpts, oldTreasury []common.Address, newTreasury []common.Address) (*AegisVaultTreasuryUpdatedIterator, error) {
+
+	var oldTreasuryRule []interface{}
+	for _, oldTreasuryItem := range oldTreasury {
+		oldTreasuryRule = append(oldTreasuryRule, oldTreasuryItem)
+	}
+	var newTreasuryRule []interface{}
+	for _, newTreasuryItem := range newTreasury {
+		newTreasuryRule = append(newTreasuryRule, newTreasuryItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "TreasuryUpdated", oldTreasuryRule, newTreasuryRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultTreasuryUpdatedIterator{contract: _AegisVault.contract, event: "TreasuryUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTreasuryUpdated is a free log subscription operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address indexed oldTreasury, address indexed newTreasury)
+func (_AegisVault *AegisVaultFilterer) WatchTreasuryUpdated(opts *bind.WatchOpts, sink chan<- *AegisVaultTreasuryUpdated, oldTreasury []common.Address, newTreasury []common.Address) (event.Subscription, error) {
+
+	var oldTreasuryRule []interface{}
+	for _, oldTreasuryItem := range oldTreasury {
+		oldTreasuryRule = append(oldTreasuryRule, oldTreasuryItem)
+	}
+	var newTreasuryRule []interface{}
+	for _, newTreasuryItem := range newTreasury {
+		newTreasuryRule = append(newTreasuryRule, newTreasuryItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "TreasuryUpdated", oldTreasuryRule, newTreasuryRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultTreasuryUpdated)
+				if err := _AegisVault.contract.UnpackLog(event, "TreasuryUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTreasuryUpdated is a log parse operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address indexed oldTreasury, address indexed newTreasury)
+func (_AegisVault *AegisVaultFilterer) ParseTreasuryUpdated(log types.Log) (*AegisVaultTreasuryUpdated, error) {
+	event := new(AegisVaultTreasuryUpdated)
+	if err := _AegisVault.contract.UnpackLog(event, "TreasuryUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the AegisVault contract.
+type AegisVaultUnpausedIterator struct {
+	Event *AegisVaultUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultUnpaused represents a Unpaused event raised by the AegisVault contract.
+type AegisVaultUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_AegisVault *AegisVaultFilterer) FilterUnpaused(opts *bind.FilterOpts) (*AegisVaultUnpausedIterator, error) {
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultUnpausedIterator{contract: _AegisVault.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_AegisVault *AegisVaultFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *AegisVaultUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultUnpaused)
+				if err := _AegisVault.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_AegisVault *AegisVaultFilterer) ParseUnpaused(log types.Log) (*AegisVaultUnpaused, error) {
+	event := new(AegisVaultUnpaused)
+	if err := _AegisVault.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the AegisVault contract.
+type AegisVaultUpgradedIterator struct {
+	Event *AegisVaultUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultUpgraded represents a Upgraded event raised by the AegisVault contract.
+type AegisVaultUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_AegisVault *AegisVaultFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*AegisVaultUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultUpgradedIterator{contract: _AegisVault.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_AegisVault *AegisVaultFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *AegisVaultUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultUpgraded)
+				if err := _AegisVault.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_AegisVault *AegisVaultFilterer) ParseUpgraded(log types.Log) (*AegisVaultUpgraded, error) {
+	event := new(AegisVaultUpgraded)
+	if err := _AegisVault.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AegisVaultWithdrawIterator is returned from FilterWithdraw and is used to iterate over the raw logs and unpacked data for Withdraw events raised by the AegisVault contract.
+type AegisVaultWithdrawIterator struct {
+	Event *AegisVaultWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AegisVaultWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AegisVaultWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AegisVaultWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AegisVaultWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AegisVaultWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AegisVaultWithdraw represents a Withdraw event raised by the AegisVault contract.
+type AegisVaultWithdraw struct {
+	Sender   common.Address
+	Receiver common.Address
+	Owner    common.Address
+	Assets   *big.Int
+	Shares   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdraw is a free log retrieval operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_AegisVault *AegisVaultFilterer) FilterWithdraw(opts *bind.FilterOpts, sender []common.Address, receiver []common.Address, owner []common.Address) (*AegisVaultWithdrawIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.FilterLogs(opts, "Withdraw", senderRule, receiverRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AegisVaultWithdrawIterator{contract: _AegisVault.contract, event: "Withdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdraw is a free log subscription operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_AegisVault *AegisVaultFilterer) WatchWithdraw(opts *bind.WatchOpts, sink chan<- *AegisVaultWithdraw, sender []common.Address, receiver []common.Address, owner []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _AegisVault.contract.WatchLogs(opts, "Withdraw", senderRule, receiverRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AegisVaultWithdraw)
+				if err := _AegisVault.contract.UnpackLog(event, "Withdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdraw is a log parse operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_AegisVault *AegisVaultFilterer) ParseWithdraw(log types.Log) (*AegisVaultWithdraw, error) {
+	event := new(AegisVaultWithdraw)
+	if err := _AegisVault.contract.UnpackLog(event, "Withdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,285 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IBridgeMetaData contains all meta data concerning the IBridge contract.
+var IBridgeMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"bridgeStatus\",\"inputs\":[{\"name\":\"txHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"completed\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"bridgeToL1\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"minGasLimit\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"bridgeToL2\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"estimateBridgeFee\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"}]",
+}
+
+// IBridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use IBridgeMetaData.ABI instead.
+var IBridgeABI = IBridgeMetaData.ABI
+
+// IBridge is an auto generated Go binding around an Ethereum contract.
+type IBridge struct {
+	IBridgeCaller     // Read-only binding to the contract
+	IBridgeTransactor // Write-only binding to the contract
+	IBridgeFilterer   // Log filterer for contract events
+}
+
+// IBridgeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IBridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBridgeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IBridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBridgeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IBridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBridgeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IBridgeSession struct {
+	Contract     *IBridge          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IBridgeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IBridgeCallerSession struct {
+	Contract *IBridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// IBridgeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IBridgeTransactorSession struct {
+	Contract     *IBridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// IBridgeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IBridgeRaw struct {
+	Contract *IBridge // Generic contract binding to access the raw methods on
+}
+
+// IBridgeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IBridgeCallerRaw struct {
+	Contract *IBridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IBridgeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IBridgeTransactorRaw struct {
+	Contract *IBridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIBridge creates a new instance of IBridge, bound to a specific deployed contract.
+func NewIBridge(address common.Address, backend bind.ContractBackend) (*IBridge, error) {
+	contract, err := bindIBridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridge{IBridgeCaller: IBridgeCaller{contract: contract}, IBridgeTransactor: IBridgeTransactor{contract: contract}, IBridgeFilterer: IBridgeFilterer{contract: contract}}, nil
+}
+
+// NewIBridgeCaller creates a new read-only instance of IBridge, bound to a specific deployed contract.
+func NewIBridgeCaller(address common.Address, caller bind.ContractCaller) (*IBridgeCaller, error) {
+	contract, err := bindIBridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeCaller{contract: contract}, nil
+}
+
+// NewIBridgeTransactor creates a new write-only instance of IBridge, bound to a specific deployed contract.
+func NewIBridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*IBridgeTransactor, error) {
+	contract, err := bindIBridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeTransactor{contract: contract}, nil
+}
+
+// NewIBridgeFilterer creates a new log filterer instance of IBridge, bound to a specific deployed contract.
+func NewIBridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*IBridgeFilterer, error) {
+	contract, err := bindIBridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeFilterer{contract: contract}, nil
+}
+
+// bindIBridge binds a generic wrapper to an already deployed contract.
+func bindIBridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IBridge *IBridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IBridge.Contract.IBridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IBridge *IBridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IBridge.Contract.IBridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IBridge *IBridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IBridge.Contract.IBridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IBridge *IBridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IBridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IBridge *IBridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IBridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IBridge *IBridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IBridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// BridgeStatus is a free data retrieval call binding the contract method 0xb02844e5.
+//
+// Solidity: function bridgeStatus(bytes32 txHash) view returns(bool completed)
+func (_IBridge *IBridgeCaller) BridgeStatus(opts *bind.CallOpts, txHash [32]byte) (bool, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "bridgeStatus", txHash)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// BridgeStatus is a free data retrieval call binding the contract method 0xb02844e5.
+//
+// Solidity: function bridgeStatus(bytes32 txHash) view returns(bool completed)
+func (_IBridge *IBridgeSession) BridgeStatus(txHash [32]byte) (bool, error) {
+	return _IBridge.Contract.BridgeStatus(&_IBridge.CallOpts, txHash)
+}
+
+// BridgeStatus is a free data retrieval call binding the contract method 0xb02844e5.
+//
+// Solidity: function bridgeStatus(bytes32 txHash) view returns(bool completed)
+func (_IBridge *IBridgeCallerSession) BridgeStatus(txHash [32]byte) (bool, error) {
+	return _IBridge.Contract.BridgeStatus(&_IBridge.CallOpts, txHash)
+}
+
+// EstimateBridgeFee is a free data retrieval call binding the contract method 0x9822dded.
+//
+// Solidity: function estimateBridgeFee(uint256 amount) view returns(uint256)
+func (_IBridge *IBridgeCaller) EstimateBridgeFee(opts *bind.CallOpts, amount *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "estimateBridgeFee", amount)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// EstimateBridgeFee is a free data retrieval call binding the contract method 0x9822dded.
+//
+// Solidity: function estimateBridgeFee(uint256 amount) view returns(uint256)
+func (_IBridge *IBridgeSession) EstimateBridgeFee(amount *big.Int) (*big.Int, error) {
+	return _IBridge.Contract.EstimateBridgeFee(&_IBridge.CallOpts, amount)
+}
+
+// EstimateBridgeFee is a free data retrieval call binding the contract method 0x9822dded.
+//
+// Solidity: function estimateBridgeFee(uint256 amount) view returns(uint256)
+func (_IBridge *IBridgeCallerSession) EstimateBridgeFee(amount *big.Int) (*big.Int, error) {
+	return _IBridge.Contract.EstimateBridgeFee(&_IBridge.CallOpts, amount)
+}
+
+// BridgeToL1 is a paid mutator transaction binding the contract method 0x5814dbbf.
+//
+// Solidity: function bridgeToL1(uint256 amount, address recipient, uint32 minGasLimit) payable returns()
+func (_IBridge *IBridgeTransactor) BridgeToL1(opts *bind.TransactOpts, amount *big.Int, recipient common.Address, minGasLimit uint32) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "bridgeToL1", amount, recipient, minGasLimit)
+}
+
+// BridgeToL1 is a paid mutator transaction binding the contract method 0x5814dbbf.
+//
+// Solidity: function bridgeToL1(uint256 amount, address recipient, uint32 minGasLimit) payable returns()
+func (_IBridge *IBridgeSession) BridgeToL1(amount *big.Int, recipient common.Address, minGasLimit uint32) (*types.Transaction, error) {
+	return _IBridge.Contract.BridgeToL1(&_IBridge.TransactOpts, amount, recipient, minGasLimit)
+}
+
+// BridgeToL1 is a paid mutator transaction binding the contract method 0x5814dbbf.
+//
+// Solidity: function bridgeToL1(uint256 amount, address recipient, uint32 minGasLimit) payable returns()
+func (_IBridge *IBridgeTransactorSession) BridgeToL1(amount *big.Int, recipient common.Address, minGasLimit uint32) (*types.Transaction, error) {
+	return _IBridge.Contract.BridgeToL1(&_IBridge.TransactOpts, amount, recipient, minGasLimit)
+}
+
+// BridgeToL2 is a paid mutator transaction binding the contract method 0x7ab266a6.
+//
+// Solidity: function bridgeToL2(uint256 amount, address recipient) payable returns()
+func (_IBridge *IBridgeTransactor) BridgeToL2(opts *bind.TransactOpts, amount *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "bridgeToL2", amount, recipient)
+}
+
+// BridgeToL2 is a paid mutator transaction binding the contract method 0x7ab266a6.
+//
+// Solidity: function bridgeToL2(uint256 amount, address recipient) payable returns()
+func (_IBridge *IBridgeSession) BridgeToL2(amount *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _IBridge.Contract.BridgeToL2(&_IBridge.TransactOpts, amount, recipient)
+}
+
+// BridgeToL2 is a paid mutator transaction binding the contract method 0x7ab266a6.
+//
+// Solidity: function bridgeToL2(uint256 amount, address recipient) payable returns()
+func (_IBridge *IBridgeTransactorSession) BridgeToL2(amount *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _IBridge.Contract.BridgeToL2(&_IBridge.TransactOpts, amount, recipient)
+}
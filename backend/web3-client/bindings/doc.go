@@ -0,0 +1,23 @@
+// Package bindings contains Go bindings for the Aegis contracts, generated by
+// abigen from the ABI JSON committed under abi/. The generated files are checked
+// in so the backend builds without Foundry or abigen installed.
+//
+// The ABIs mirror the Solidity sources in contracts/src; inherited OpenZeppelin
+// members follow the v4 upgradeable contracts those sources import. After
+// changing a contract, update the matching file in abi/, check it with
+//
+//	go test ./web3-client/bindings
+//
+// which compares the ABIs with the Solidity sources (and, when forge is installed,
+// with the compiled contracts), and regenerate the bindings with
+//
+//	go generate ./web3-client/bindings
+package bindings
+
+// abigen v1.13.5 links against a runtime symbol newer Go toolchains hide, hence -checklinkname=0
+
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen@v1.13.5 --abi abi/AegisController.json --pkg bindings --type AegisController --out aegis_controller.go
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen@v1.13.5 --abi abi/AegisVault.json --pkg bindings --type AegisVault --out aegis_vault.go
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen@v1.13.5 --abi abi/IAegisStrategy.json --pkg bindings --type IAegisStrategy --out aegis_strategy.go
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen@v1.13.5 --abi abi/IRiskOracle.json --pkg bindings --type IRiskOracle --out risk_oracle.go
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen@v1.13.5 --abi abi/IBridge.json --pkg bindings --type IBridge --out bridge.go
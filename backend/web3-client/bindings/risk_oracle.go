@@ -0,0 +1,303 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IRiskOracleRiskMetrics is an auto generated low-level Go binding around an user-defined struct.
+type IRiskOracleRiskMetrics struct {
+	Volatility     *big.Int
+	LiquidityDepth *big.Int
+	ProtocolHealth *big.Int
+	Timestamp      *big.Int
+}
+
+// IRiskOracleMetaData contains all meta data concerning the IRiskOracle contract.
+var IRiskOracleMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"getMaxAllocation\",\"inputs\":[{\"name\":\"protocol\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"maxAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRiskMetrics\",\"inputs\":[{\"name\":\"protocol\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"metrics\",\"type\":\"tuple\",\"internalType\":\"structIRiskOracle.RiskMetrics\",\"components\":[{\"name\":\"volatility\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"liquidityDepth\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"protocolHealth\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isProtocolSafe\",\"inputs\":[{\"name\":\"protocol\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"safe\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"updateRiskMetrics\",\"inputs\":[{\"name\":\"protocol\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"metrics\",\"type\":\"tuple\",\"internalType\":\"structIRiskOracle.RiskMetrics\",\"components\":[{\"name\":\"volatility\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"liquidityDepth\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"protocolHealth\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"}]",
+}
+
+// IRiskOracleABI is the input ABI used to generate the binding from.
+// Deprecated: Use IRiskOracleMetaData.ABI instead.
+var IRiskOracleABI = IRiskOracleMetaData.ABI
+
+// IRiskOracle is an auto generated Go binding around an Ethereum contract.
+type IRiskOracle struct {
+	IRiskOracleCaller     // Read-only binding to the contract
+	IRiskOracleTransactor // Write-only binding to the contract
+	IRiskOracleFilterer   // Log filterer for contract events
+}
+
+// IRiskOracleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IRiskOracleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IRiskOracleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IRiskOracleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IRiskOracleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IRiskOracleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IRiskOracleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IRiskOracleSession struct {
+	Contract     *IRiskOracle      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IRiskOracleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IRiskOracleCallerSession struct {
+	Contract *IRiskOracleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// IRiskOracleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IRiskOracleTransactorSession struct {
+	Contract     *IRiskOracleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// IRiskOracleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IRiskOracleRaw struct {
+	Contract *IRiskOracle // Generic contract binding to access the raw methods on
+}
+
+// IRiskOracleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IRiskOracleCallerRaw struct {
+	Contract *IRiskOracleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IRiskOracleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IRiskOracleTransactorRaw struct {
+	Contract *IRiskOracleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIRiskOracle creates a new instance of IRiskOracle, bound to a specific deployed contract.
+func NewIRiskOracle(address common.Address, backend bind.ContractBackend) (*IRiskOracle, error) {
+	contract, err := bindIRiskOracle(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IRiskOracle{IRiskOracleCaller: IRiskOracleCaller{contract: contract}, IRiskOracleTransactor: IRiskOracleTransactor{contract: contract}, IRiskOracleFilterer: IRiskOracleFilterer{contract: contract}}, nil
+}
+
+// NewIRiskOracleCaller creates a new read-only instance of IRiskOracle, bound to a specific deployed contract.
+func NewIRiskOracleCaller(address common.Address, caller bind.ContractCaller) (*IRiskOracleCaller, error) {
+	contract, err := bindIRiskOracle(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IRiskOracleCaller{contract: contract}, nil
+}
+
+// NewIRiskOracleTransactor creates a new write-only instance of IRiskOracle, bound to a specific deployed contract.
+func NewIRiskOracleTransactor(address common.Address, transactor bind.ContractTransactor) (*IRiskOracleTransactor, error) {
+	contract, err := bindIRiskOracle(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IRiskOracleTransactor{contract: contract}, nil
+}
+
+// NewIRiskOracleFilterer creates a new log filterer instance of IRiskOracle, bound to a specific deployed contract.
+func NewIRiskOracleFilterer(address common.Address, filterer bind.ContractFilterer) (*IRiskOracleFilterer, error) {
+	contract, err := bindIRiskOracle(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IRiskOracleFilterer{contract: contract}, nil
+}
+
+// bindIRiskOracle binds a generic wrapper to an already deployed contract.
+func bindIRiskOracle(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IRiskOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IRiskOracle *IRiskOracleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IRiskOracle.Contract.IRiskOracleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IRiskOracle *IRiskOracleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IRiskOracle.Contract.IRiskOracleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IRiskOracle *IRiskOracleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IRiskOracle.Contract.IRiskOracleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IRiskOracle *IRiskOracleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IRiskOracle.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IRiskOracle *IRiskOracleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IRiskOracle.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IRiskOracle *IRiskOracleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IRiskOracle.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetMaxAllocation is a free data retrieval call binding the contract method 0x57b6527e.
+//
+// Solidity: function getMaxAllocation(address protocol) view returns(uint256 maxAllocation)
+func (_IRiskOracle *IRiskOracleCaller) GetMaxAllocation(opts *bind.CallOpts, protocol common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IRiskOracle.contract.Call(opts, &out, "getMaxAllocation", protocol)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMaxAllocation is a free data retrieval call binding the contract method 0x57b6527e.
+//
+// Solidity: function getMaxAllocation(address protocol) view returns(uint256 maxAllocation)
+func (_IRiskOracle *IRiskOracleSession) GetMaxAllocation(protocol common.Address) (*big.Int, error) {
+	return _IRiskOracle.Contract.GetMaxAllocation(&_IRiskOracle.CallOpts, protocol)
+}
+
+// GetMaxAllocation is a free data retrieval call binding the contract method 0x57b6527e.
+//
+// Solidity: function getMaxAllocation(address protocol) view returns(uint256 maxAllocation)
+func (_IRiskOracle *IRiskOracleCallerSession) GetMaxAllocation(protocol common.Address) (*big.Int, error) {
+	return _IRiskOracle.Contract.GetMaxAllocation(&_IRiskOracle.CallOpts, protocol)
+}
+
+// GetRiskMetrics is a free data retrieval call binding the contract method 0xead0581d.
+//
+// Solidity: function getRiskMetrics(address protocol) view returns((uint256,uint256,uint256,uint256) metrics)
+func (_IRiskOracle *IRiskOracleCaller) GetRiskMetrics(opts *bind.CallOpts, protocol common.Address) (IRiskOracleRiskMetrics, error) {
+	var out []interface{}
+	err := _IRiskOracle.contract.Call(opts, &out, "getRiskMetrics", protocol)
+
+	if err != nil {
+		return *new(IRiskOracleRiskMetrics), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IRiskOracleRiskMetrics)).(*IRiskOracleRiskMetrics)
+
+	return out0, err
+
+}
+
+// GetRiskMetrics is a free data retrieval call binding the contract method 0xead0581d.
+//
+// Solidity: function getRiskMetrics(address protocol) view returns((uint256,uint256,uint256,uint256) metrics)
+func (_IRiskOracle *IRiskOracleSession) GetRiskMetrics(protocol common.Address) (IRiskOracleRiskMetrics, error) {
+	return _IRiskOracle.Contract.GetRiskMetrics(&_IRiskOracle.CallOpts, protocol)
+}
+
+// GetRiskMetrics is a free data retrieval call binding the contract method 0xead0581d.
+//
+// Solidity: function getRiskMetrics(address protocol) view returns((uint256,uint256,uint256,uint256) metrics)
+func (_IRiskOracle *IRiskOracleCallerSession) GetRiskMetrics(protocol common.Address) (IRiskOracleRiskMetrics, error) {
+	return _IRiskOracle.Contract.GetRiskMetrics(&_IRiskOracle.CallOpts, protocol)
+}
+
+// IsProtocolSafe is a free data retrieval call binding the contract method 0x41a6a985.
+//
+// Solidity: function isProtocolSafe(address protocol) view returns(bool safe)
+func (_IRiskOracle *IRiskOracleCaller) IsProtocolSafe(opts *bind.CallOpts, protocol common.Address) (bool, error) {
+	var out []interface{}
+	err := _IRiskOracle.contract.Call(opts, &out, "isProtocolSafe", protocol)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsProtocolSafe is a free data retrieval call binding the contract method 0x41a6a985.
+//
+// Solidity: function isProtocolSafe(address protocol) view returns(bool safe)
+func (_IRiskOracle *IRiskOracleSession) IsProtocolSafe(protocol common.Address) (bool, error) {
+	return _IRiskOracle.Contract.IsProtocolSafe(&_IRiskOracle.CallOpts, protocol)
+}
+
+// IsProtocolSafe is a free data retrieval call binding the contract method 0x41a6a985.
+//
+// Solidity: function isProtocolSafe(address protocol) view returns(bool safe)
+func (_IRiskOracle *IRiskOracleCallerSession) IsProtocolSafe(protocol common.Address) (bool, error) {
+	return _IRiskOracle.Contract.IsProtocolSafe(&_IRiskOracle.CallOpts, protocol)
+}
+
+// UpdateRiskMetrics is a paid mutator transaction binding the contract method 0x21e7b666.
+//
+// Solidity: function updateRiskMetrics(address protocol, (uint256,uint256,uint256,uint256) metrics) returns()
+func (_IRiskOracle *IRiskOracleTransactor) UpdateRiskMetrics(opts *bind.TransactOpts, protocol common.Address, metrics IRiskOracleRiskMetrics) (*types.Transaction, error) {
+	return _IRiskOracle.contract.Transact(opts, "updateRiskMetrics", protocol, metrics)
+}
+
+// UpdateRiskMetrics is a paid mutator transaction binding the contract method 0x21e7b666.
+//
+// Solidity: function updateRiskMetrics(address protocol, (uint256,uint256,uint256,uint256) metrics) returns()
+func (_IRiskOracle *IRiskOracleSession) UpdateRiskMetrics(protocol common.Address, metrics IRiskOracleRiskMetrics) (*types.Transaction, error) {
+	return _IRiskOracle.Contract.UpdateRiskMetrics(&_IRiskOracle.TransactOpts, protocol, metrics)
+}
+
+// UpdateRiskMetrics is a paid mutator transaction binding the contract method 0x21e7b666.
+//
+// Solidity: function updateRiskMetrics(address protocol, (uint256,uint256,uint256,uint256) metrics) returns()
+func (_IRiskOracle *IRiskOracleTransactorSession) UpdateRiskMetrics(protocol common.Address, metrics IRiskOracleRiskMetrics) (*types.Transaction, error) {
+	return _IRiskOracle.Contract.UpdateRiskMetrics(&_IRiskOracle.TransactOpts, protocol, metrics)
+}
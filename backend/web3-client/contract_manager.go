@@ -2,18 +2,16 @@ package web3client
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"os"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/sirupsen/logrus"
 )
 
@@ -37,39 +35,33 @@ type DeploymentArtifacts struct {
 
 // ContractManager manages all contract interactions
 type ContractManager struct {
-	client     *ethclient.Client
+	client     Backend
 	artifacts  *DeploymentArtifacts
-	privateKey *ecdsa.PrivateKey
+	signer     Signer
 	auth       *bind.TransactOpts
+	tracker    *TxTracker
+	nonces     *NonceManager
+	fees       FeeStrategy
+	maxFee     *big.Int
 	logger     *logrus.Logger
 }
 
-// NewContractManager creates a new contract manager instance
-func NewContractManager(rpcURL, artifactsPath, privateKeyHex string, logger *logrus.Logger) (*ContractManager, error) {
-	// Connect to Base RPC
-	client, err := ethclient.Dial(rpcURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to Base RPC: %w", err)
-	}
+// defaultStuckTimeout is how long a keeper transaction may stay pending before it is replaced
+const defaultStuckTimeout = 3 * time.Minute
 
+// NewContractManager creates a new contract manager instance. The client is either a
+// single *ethclient.Client or a *MultiClient spanning several RPC providers, and
+// transactions are signed by signer.
+func NewContractManager(client Backend, artifactsPath string, signer Signer, logger *logrus.Logger) (*ContractManager, error) {
 	// Load deployment artifacts
-	artifacts, err := loadDeploymentArtifacts(artifactsPath)
+	artifacts, err := LoadDeploymentArtifacts(artifactsPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load deployment artifacts: %w", err)
 	}
 
-	// Load private key
-	privateKey, err := crypto.HexToECDSA(privateKeyHex)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load private key: %w", err)
-	}
-
-	// Create transactor with Base chain ID (8453)
-	chainID := big.NewInt(int64(artifacts.ChainID))
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create transactor: %w", err)
-	}
+	// Sign for the chain the contracts were deployed to
+	chainID := new(big.Int).SetUint64(artifacts.ChainID)
+	auth := NewTransactOpts(context.Background(), signer, chainID)
 
 	logger.WithFields(logrus.Fields{
 		"network":    artifacts.Network,
@@ -81,14 +73,17 @@ func NewContractManager(rpcURL, artifactsPath, privateKeyHex string, logger *log
 	return &ContractManager{
 		client:     client,
 		artifacts:  artifacts,
-		privateKey: privateKey,
+		signer:     signer,
 		auth:       auth,
+		tracker:    NewTxTracker(client, DefaultTrackerConfig(), logger),
+		nonces:     NewNonceManager(client, auth.From, auth.Signer, defaultStuckTimeout, nil, logger),
+		fees:       &BaseFeeMultiplierStrategy{Multiplier: 2, Tip: GweiToWei(0.001)},
 		logger:     logger,
 	}, nil
 }
 
-// loadDeploymentArtifacts loads the deployment JSON file
-func loadDeploymentArtifacts(path string) (*DeploymentArtifacts, error) {
+// LoadDeploymentArtifacts loads the deployment JSON file
+func LoadDeploymentArtifacts(path string) (*DeploymentArtifacts, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read artifacts file: %w", err)
@@ -102,8 +97,8 @@ func loadDeploymentArtifacts(path string) (*DeploymentArtifacts, error) {
 	return &artifacts, nil
 }
 
-// GetClient returns the eth client
-func (cm *ContractManager) GetClient() *ethclient.Client {
+// GetClient returns the RPC backend
+func (cm *ContractManager) GetClient() Backend {
 	return cm.client
 }
 
@@ -112,30 +107,57 @@ func (cm *ContractManager) GetControllerAddress() common.Address {
 	return cm.artifacts.ControllerProxy
 }
 
+// GetKeeperAddress returns the address transactions are sent from
+func (cm *ContractManager) GetKeeperAddress() common.Address {
+	return cm.auth.From
+}
+
+// GetArtifacts returns the deployment artifacts the manager was created with
+func (cm *ContractManager) GetArtifacts() *DeploymentArtifacts {
+	return cm.artifacts
+}
+
 // GetVaultAddress returns the vault proxy address
 func (cm *ContractManager) GetVaultAddress() common.Address {
 	return cm.artifacts.VaultProxy
 }
 
-// GetAuth returns the transaction auth with updated gas settings
+// SetFeeStrategy changes the EIP-1559 fee strategy and the hard max-fee ceiling, which
+// also caps the fees of replacement transactions. A nil or zero ceiling disables it.
+func (cm *ContractManager) SetFeeStrategy(strategy FeeStrategy, maxFee *big.Int) {
+	cm.fees = strategy
+	cm.maxFee = maxFee
+	cm.nonces.SetMaxFee(maxFee)
+}
+
+// SuggestFees returns the EIP-1559 fees the next transaction would use.
+// It returns a FeeCeilingError when the network requires more than the max-fee ceiling.
+func (cm *ContractManager) SuggestFees(ctx context.Context) (*FeeSuggestion, error) {
+	return SuggestFees(ctx, cm.client, cm.fees, cm.maxFee)
+}
+
+// GetAuth returns the transaction auth with updated gas settings.
+// It reserves a nonce: callers must submit a transaction with it or call ReleaseNonce.
 func (cm *ContractManager) GetAuth(ctx context.Context) (*bind.TransactOpts, error) {
-	// Get latest nonce
-	nonce, err := cm.client.PendingNonceAt(ctx, cm.auth.From)
+	// Get dynamic fees from recent fee history
+	fees, err := cm.SuggestFees(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %w", err)
+		return nil, err
 	}
 
-	// Get suggested gas price
-	gasPrice, err := cm.client.SuggestGasPrice(ctx)
+	// Reserve the next nonce
+	nonce, err := cm.nonces.Next(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get gas price: %w", err)
+		return nil, err
 	}
 
 	// Create new auth with updated values
 	auth := *cm.auth
-	auth.Nonce = big.NewInt(int64(nonce))
-	auth.GasPrice = gasPrice
+	auth.Nonce = new(big.Int).SetUint64(nonce)
+	auth.GasTipCap = fees.GasTipCap
+	auth.GasFeeCap = fees.GasFeeCap
 	auth.Context = ctx
+	auth.Signer = SignerFn(ctx, cm.signer, new(big.Int).SetUint64(cm.artifacts.ChainID))
 
 	return &auth, nil
 }
@@ -150,33 +172,134 @@ func (cm *ContractManager) EstimateGas(ctx context.Context, to common.Address, d
 
 	gasLimit, err := cm.client.EstimateGas(ctx, msg)
 	if err != nil {
-		return 0, fmt.Errorf("failed to estimate gas: %w", err)
+		return 0, fmt.Errorf("failed to estimate gas: %w", decodeRevertError(err))
 	}
 
 	// Add 20% buffer
 	return gasLimit * 120 / 100, nil
 }
 
-// WaitForTransaction waits for a transaction to be mined
-func (cm *ContractManager) WaitForTransaction(ctx context.Context, txHash common.Hash) error {
-	cm.logger.WithField("txHash", txHash.Hex()).Info("Waiting for transaction confirmation...")
+// SendTransaction estimates gas for a contract call, signs it with the keeper signer and submits it
+func (cm *ContractManager) SendTransaction(ctx context.Context, to common.Address, data []byte) (*types.Transaction, error) {
+	gasLimit, err := cm.EstimateGas(ctx, to, data)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := cm.GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   new(big.Int).SetUint64(cm.artifacts.ChainID),
+		Nonce:     auth.Nonce.Uint64(),
+		GasTipCap: auth.GasTipCap,
+		GasFeeCap: auth.GasFeeCap,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     big.NewInt(0),
+		Data:      data,
+	})
 
-	receipt, err := bind.WaitMined(ctx, cm.client, &types.Transaction{})
+	signedTx, err := auth.Signer(auth.From, tx)
 	if err != nil {
-		return fmt.Errorf("failed to wait for transaction: %w", err)
+		cm.nonces.Release(tx.Nonce())
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
 	}
 
-	if receipt.Status == 0 {
-		return fmt.Errorf("transaction failed: %s", txHash.Hex())
+	if err := cm.client.SendTransaction(ctx, signedTx); err != nil {
+		cm.nonces.HandleSendError(tx.Nonce(), err)
+		return nil, fmt.Errorf("failed to send transaction: %w", decodeRevertError(err))
 	}
+	cm.nonces.Track(signedTx)
 
 	cm.logger.WithFields(logrus.Fields{
-		"txHash":      txHash.Hex(),
-		"blockNumber": receipt.BlockNumber,
-		"gasUsed":     receipt.GasUsed,
-	}).Info("Transaction confirmed")
+		"txHash":   signedTx.Hash().Hex(),
+		"to":       to.Hex(),
+		"nonce":    signedTx.Nonce(),
+		"gasLimit": gasLimit,
+	}).Info("Transaction submitted")
+
+	return signedTx, nil
+}
+
+// GetRevertReason replays a mined transaction at its block to recover the revert reason
+func (cm *ContractManager) GetRevertReason(ctx context.Context, tx *types.Transaction, blockNumber *big.Int) error {
+	msg := ethereum.CallMsg{
+		From:  cm.auth.From,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+
+	if _, err := cm.client.CallContract(ctx, msg, blockNumber); err != nil {
+		return decodeRevertError(err)
+	}
+
+	return &RevertError{Reason: "reverted without reason (call succeeds on replay)"}
+}
+
+// SetTrackerConfig changes the confirmation settings used by WaitForTransaction
+func (cm *ContractManager) SetTrackerConfig(config TrackerConfig) {
+	cm.tracker = NewTxTracker(cm.client, config, cm.logger)
+}
+
+// ReleaseNonce returns a nonce reserved by GetAuth that was not used
+func (cm *ContractManager) ReleaseNonce(nonce uint64) {
+	cm.nonces.Release(nonce)
+}
+
+// SetStuckTimeout changes how long a transaction may stay pending before it is replaced
+func (cm *ContractManager) SetStuckTimeout(timeout time.Duration) {
+	cm.nonces.SetStuckAfter(timeout)
+}
+
+// MonitorPendingTransactions replaces stuck keeper transactions until the context is done
+func (cm *ContractManager) MonitorPendingTransactions(ctx context.Context, interval time.Duration) {
+	cm.nonces.Monitor(ctx, interval)
+}
+
+// WaitForTransaction waits for a transaction to be mined and confirmed.
+// If the transaction was replaced by the nonce manager, the replacement is followed,
+// whether the original was seen replaced or dropped from the mempool in its favour.
+// The result's Hash is that of the transaction that was finally tracked.
+func (cm *ContractManager) WaitForTransaction(ctx context.Context, txHash common.Hash) (*TxResult, error) {
+	cm.logger.WithField("txHash", txHash.Hex()).Info("Waiting for transaction confirmation...")
+
+	result, err := cm.tracker.Wait(ctx, txHash)
+	for err == nil && (result.Status == TxStatusReplaced || result.Status == TxStatusDropped) {
+		replacement, ok := cm.nonces.ReplacementFor(result.Hash)
+		if !ok {
+			break
+		}
+		cm.logger.WithFields(logrus.Fields{
+			"txHash":      result.Hash.Hex(),
+			"replacement": replacement.Hex(),
+		}).Info("Following replacement transaction")
+		result, err = cm.tracker.Wait(ctx, replacement)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction: %w", err)
+	}
+
+	fields := logrus.Fields{
+		"txHash": result.Hash.Hex(),
+		"status": result.Status,
+		"reorgs": result.Reorgs,
+	}
+	if result.Hash != txHash {
+		fields["original"] = txHash.Hex()
+	}
+	if result.Receipt != nil {
+		fields["blockNumber"] = result.Receipt.BlockNumber
+		fields["gasUsed"] = result.Receipt.GasUsed
+		fields["confirmations"] = result.Confirmations
+	}
+	cm.logger.WithFields(fields).Info("Transaction tracking finished")
 
-	return nil
+	return result, nil
 }
 
 // Close closes the client connection
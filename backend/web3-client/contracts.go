@@ -0,0 +1,216 @@
+package web3client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/aegis-yield/backend/web3-client/bindings"
+)
+
+// The controller and strategy ABIs come from the generated bindings
+var (
+	controllerABI = mustParseABI(bindings.AegisControllerMetaData.ABI)
+	strategyABI   = mustParseABI(bindings.IAegisStrategyMetaData.ABI)
+)
+
+// TargetAllocation is IAegisController.TargetAllocation from the generated bindings
+type TargetAllocation = bindings.IAegisControllerTargetAllocation
+
+// PackRebalance ABI-encodes a call to IAegisController.rebalance
+func PackRebalance(targets []TargetAllocation) ([]byte, error) {
+	return controllerABI.Pack("rebalance", targets)
+}
+
+// Rebalance submits IAegisController.rebalance with the given targets
+func (cm *ContractManager) Rebalance(ctx context.Context, targets []TargetAllocation) (*types.Transaction, error) {
+	data, err := PackRebalance(targets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode rebalance call: %w", err)
+	}
+
+	return cm.SendTransaction(ctx, cm.GetControllerAddress(), data)
+}
+
+// quorumCaller routes contract calls through QuorumCallContract
+type quorumCaller struct {
+	Backend
+	quorum QuorumCaller
+}
+
+func (q *quorumCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return q.quorum.QuorumCallContract(ctx, call, blockNumber)
+}
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(fmt.Sprintf("invalid contract ABI: %v", err))
+	}
+	return parsed
+}
+
+// call executes a read-only contract call at blockNumber, or the latest block when
+// nil, and returns the unpacked outputs
+func (cm *ContractManager) call(ctx context.Context, blockNumber *big.Int, contractABI abi.ABI, address common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	return cm.callWith(ctx, cm.client, blockNumber, contractABI, address, method, args...)
+}
+
+// callQuorum is call for critical reads: when the backend supports it, the result
+// must be confirmed by the configured quorum of RPC providers
+func (cm *ContractManager) callQuorum(ctx context.Context, blockNumber *big.Int, contractABI abi.ABI, address common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	var caller bind.ContractCaller = cm.client
+	if quorum, ok := cm.client.(QuorumCaller); ok {
+		caller = &quorumCaller{Backend: cm.client, quorum: quorum}
+	}
+	return cm.callWith(ctx, caller, blockNumber, contractABI, address, method, args...)
+}
+
+func (cm *ContractManager) callWith(ctx context.Context, caller bind.ContractCaller, blockNumber *big.Int, contractABI abi.ABI, address common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	contract := bind.NewBoundContract(address, contractABI, caller, cm.client, cm.client)
+
+	var out []interface{}
+	if err := contract.Call(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, &out, method, args...); err != nil {
+		return nil, fmt.Errorf("failed to call %s on %s: %w", method, address.Hex(), err)
+	}
+
+	return out, nil
+}
+
+// callUint256 executes a read-only call that returns a single uint256
+func (cm *ContractManager) callUint256(ctx context.Context, blockNumber *big.Int, contractABI abi.ABI, address common.Address, method string, args ...interface{}) (*big.Int, error) {
+	out, err := cm.call(ctx, blockNumber, contractABI, address, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	return unpackUint256(method, out)
+}
+
+// callQuorumUint256 is callUint256 confirmed by the RPC quorum
+func (cm *ContractManager) callQuorumUint256(ctx context.Context, blockNumber *big.Int, contractABI abi.ABI, address common.Address, method string, args ...interface{}) (*big.Int, error) {
+	out, err := cm.callQuorum(ctx, blockNumber, contractABI, address, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	return unpackUint256(method, out)
+}
+
+func unpackUint256(method string, out []interface{}) (*big.Int, error) {
+
+	value, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s return type %T", method, out[0])
+	}
+
+	return value, nil
+}
+
+// ReadBlock returns a block number for pinning related reads to one chain state.
+// The getters below read at the block they are given, or the latest block when nil,
+// so reads pinned to the same block cannot straddle a rebalance or deposit. With
+// several RPC providers it is the lowest healthy head, which all of them can serve.
+func (cm *ContractManager) ReadBlock(ctx context.Context) (*big.Int, error) {
+	var number uint64
+	var err error
+	if reader, ok := cm.client.(CommonBlockReader); ok {
+		number, err = reader.CommonBlockNumber(ctx)
+	} else {
+		number, err = cm.client.BlockNumber(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read block number: %w", err)
+	}
+	return new(big.Int).SetUint64(number), nil
+}
+
+// GetTotalAssets returns the controller's total assets under management
+func (cm *ContractManager) GetTotalAssets(ctx context.Context, blockNumber *big.Int) (*big.Int, error) {
+	return cm.callQuorumUint256(ctx, blockNumber, controllerABI, cm.GetControllerAddress(), "totalAssets")
+}
+
+// GetStrategies returns the strategies registered with the controller
+func (cm *ContractManager) GetStrategies(ctx context.Context, blockNumber *big.Int) ([]common.Address, error) {
+	out, err := cm.call(ctx, blockNumber, controllerABI, cm.GetControllerAddress(), "getStrategies")
+	if err != nil {
+		return nil, err
+	}
+
+	strategies, ok := out[0].([]common.Address)
+	if !ok {
+		return nil, fmt.Errorf("unexpected getStrategies return type %T", out[0])
+	}
+
+	return strategies, nil
+}
+
+// GetKeeperRole returns the controller's KEEPER_ROLE identifier
+func (cm *ContractManager) GetKeeperRole(ctx context.Context) (common.Hash, error) {
+	out, err := cm.call(ctx, nil, controllerABI, cm.GetControllerAddress(), "KEEPER_ROLE")
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	role, ok := out[0].([32]byte)
+	if !ok {
+		return common.Hash{}, fmt.Errorf("unexpected KEEPER_ROLE return type %T", out[0])
+	}
+
+	return common.Hash(role), nil
+}
+
+// HasRole reports whether an account holds a role on the controller
+func (cm *ContractManager) HasRole(ctx context.Context, role common.Hash, account common.Address) (bool, error) {
+	out, err := cm.call(ctx, nil, controllerABI, cm.GetControllerAddress(), "hasRole", role, account)
+	if err != nil {
+		return false, err
+	}
+
+	granted, ok := out[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected hasRole return type %T", out[0])
+	}
+
+	return granted, nil
+}
+
+// GetStrategyAllocation returns the amount the controller has allocated to a strategy
+func (cm *ContractManager) GetStrategyAllocation(ctx context.Context, strategy common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return cm.callQuorumUint256(ctx, blockNumber, controllerABI, cm.GetControllerAddress(), "strategyAllocation", strategy)
+}
+
+// GetStrategyAllocationLimit returns the controller's maximum allocation for a strategy in basis points
+func (cm *ContractManager) GetStrategyAllocationLimit(ctx context.Context, strategy common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return cm.callUint256(ctx, blockNumber, controllerABI, cm.GetControllerAddress(), "strategyConfigs", strategy)
+}
+
+// GetStrategyAPY returns the strategy's current APY in basis points
+func (cm *ContractManager) GetStrategyAPY(ctx context.Context, strategy common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return cm.callUint256(ctx, blockNumber, strategyABI, strategy, "currentAPY")
+}
+
+// GetStrategyRiskScore returns the strategy's risk score (0-100)
+func (cm *ContractManager) GetStrategyRiskScore(ctx context.Context, strategy common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return cm.callUint256(ctx, blockNumber, strategyABI, strategy, "riskScore")
+}
+
+// GetStrategyName returns the strategy's on-chain name
+func (cm *ContractManager) GetStrategyName(ctx context.Context, strategy common.Address, blockNumber *big.Int) (string, error) {
+	out, err := cm.call(ctx, blockNumber, strategyABI, strategy, "name")
+	if err != nil {
+		return "", err
+	}
+
+	name, ok := out[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected name return type %T", out[0])
+	}
+
+	return name, nil
+}
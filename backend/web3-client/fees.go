@@ -0,0 +1,203 @@
+package web3client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// feeHistoryBlocks is the number of recent blocks sampled from eth_feeHistory
+	feeHistoryBlocks = 20
+)
+
+// feeHistoryPercentiles are the priority fee percentiles requested from eth_feeHistory
+var feeHistoryPercentiles = []float64{10, 25, 50, 75, 90}
+
+// FeeSuggestion is an EIP-1559 fee quote for the next block
+type FeeSuggestion struct {
+	BaseFee   *big.Int // Base fee of the next block
+	GasTipCap *big.Int // maxPriorityFeePerGas
+	GasFeeCap *big.Int // maxFeePerGas
+}
+
+// EffectiveGasPrice is the price paid per gas if the next block's base fee holds
+func (f *FeeSuggestion) EffectiveGasPrice() *big.Int {
+	price := new(big.Int).Add(f.BaseFee, f.GasTipCap)
+	if price.Cmp(f.GasFeeCap) > 0 {
+		return new(big.Int).Set(f.GasFeeCap)
+	}
+	return price
+}
+
+// FeeCeilingError is returned when the network requires more than the configured max fee
+type FeeCeilingError struct {
+	Required *big.Int
+	Ceiling  *big.Int
+}
+
+func (e *FeeCeilingError) Error() string {
+	return fmt.Sprintf("required fee %s gwei exceeds ceiling %s gwei", weiToGwei(e.Required), weiToGwei(e.Ceiling))
+}
+
+// FeeStrategy turns recent fee history into an EIP-1559 fee quote
+type FeeStrategy interface {
+	Name() string
+	SuggestFees(history *ethereum.FeeHistory) (*FeeSuggestion, error)
+}
+
+// PercentileTipStrategy tips the median, across recent blocks, of the given reward
+// percentile and allows the base fee to grow by BaseFeeMultiplier
+type PercentileTipStrategy struct {
+	percentileIndex   int
+	BaseFeeMultiplier float64
+}
+
+// NewPercentileTipStrategy creates a percentile tip strategy. The percentile must be
+// one of 10, 25, 50, 75 or 90.
+func NewPercentileTipStrategy(percentile, baseFeeMultiplier float64) (*PercentileTipStrategy, error) {
+	for i, p := range feeHistoryPercentiles {
+		if p == percentile {
+			return &PercentileTipStrategy{percentileIndex: i, BaseFeeMultiplier: baseFeeMultiplier}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported priority fee percentile %v (supported: %v)", percentile, feeHistoryPercentiles)
+}
+
+// Name implements FeeStrategy
+func (s *PercentileTipStrategy) Name() string { return "percentile" }
+
+// SuggestFees implements FeeStrategy
+func (s *PercentileTipStrategy) SuggestFees(history *ethereum.FeeHistory) (*FeeSuggestion, error) {
+	baseFee, err := nextBaseFee(history)
+	if err != nil {
+		return nil, err
+	}
+
+	tips := make([]*big.Int, 0, len(history.Reward))
+	for _, rewards := range history.Reward {
+		if s.percentileIndex < len(rewards) && rewards[s.percentileIndex] != nil {
+			tips = append(tips, rewards[s.percentileIndex])
+		}
+	}
+	if len(tips) == 0 {
+		return nil, errors.New("fee history contains no priority fee rewards")
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	tip := new(big.Int).Set(tips[len(tips)/2])
+
+	return &FeeSuggestion{
+		BaseFee:   baseFee,
+		GasTipCap: tip,
+		GasFeeCap: new(big.Int).Add(mulFloat(baseFee, s.BaseFeeMultiplier), tip),
+	}, nil
+}
+
+// BaseFeeMultiplierStrategy pays a fixed tip and sets the max fee to the next base fee
+// times Multiplier (GAS_PRICE_MULTIPLIER) plus the tip
+type BaseFeeMultiplierStrategy struct {
+	Multiplier float64
+	Tip        *big.Int
+}
+
+// Name implements FeeStrategy
+func (s *BaseFeeMultiplierStrategy) Name() string { return "multiplier" }
+
+// SuggestFees implements FeeStrategy
+func (s *BaseFeeMultiplierStrategy) SuggestFees(history *ethereum.FeeHistory) (*FeeSuggestion, error) {
+	baseFee, err := nextBaseFee(history)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FeeSuggestion{
+		BaseFee:   baseFee,
+		GasTipCap: new(big.Int).Set(s.Tip),
+		GasFeeCap: new(big.Int).Add(mulFloat(baseFee, s.Multiplier), s.Tip),
+	}, nil
+}
+
+// FixedCapStrategy always uses the configured max fee and priority fee
+type FixedCapStrategy struct {
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+}
+
+// Name implements FeeStrategy
+func (s *FixedCapStrategy) Name() string { return "fixed" }
+
+// SuggestFees implements FeeStrategy
+func (s *FixedCapStrategy) SuggestFees(history *ethereum.FeeHistory) (*FeeSuggestion, error) {
+	baseFee, err := nextBaseFee(history)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FeeSuggestion{
+		BaseFee:   baseFee,
+		GasTipCap: new(big.Int).Set(s.MaxPriorityFeePerGas),
+		GasFeeCap: new(big.Int).Set(s.MaxFeePerGas),
+	}, nil
+}
+
+// feeHistoryBackend is the subset of the RPC client needed to quote fees
+type feeHistoryBackend interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// SuggestFees fetches recent fee history, applies the strategy and enforces the ceiling.
+// The max fee is clamped to the ceiling when the next block is still affordable; a
+// FeeCeilingError is returned when base fee plus tip already exceeds it.
+func SuggestFees(ctx context.Context, client feeHistoryBackend, strategy FeeStrategy, ceiling *big.Int) (*FeeSuggestion, error) {
+	history, err := client.FeeHistory(ctx, feeHistoryBlocks, nil, feeHistoryPercentiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee history: %w", err)
+	}
+
+	fees, err := strategy.SuggestFees(history)
+	if err != nil {
+		return nil, fmt.Errorf("%s fee strategy failed: %w", strategy.Name(), err)
+	}
+
+	if fees.GasFeeCap.Cmp(fees.GasTipCap) < 0 {
+		fees.GasFeeCap = new(big.Int).Set(fees.GasTipCap)
+	}
+
+	if ceiling != nil && ceiling.Sign() > 0 && fees.GasFeeCap.Cmp(ceiling) > 0 {
+		required := new(big.Int).Add(fees.BaseFee, fees.GasTipCap)
+		if required.Cmp(ceiling) > 0 {
+			return nil, &FeeCeilingError{Required: required, Ceiling: ceiling}
+		}
+		fees.GasFeeCap = new(big.Int).Set(ceiling)
+	}
+
+	return fees, nil
+}
+
+// nextBaseFee returns the base fee of the block after the sampled range
+func nextBaseFee(history *ethereum.FeeHistory) (*big.Int, error) {
+	if len(history.BaseFee) == 0 {
+		return nil, errors.New("fee history contains no base fees")
+	}
+	return new(big.Int).Set(history.BaseFee[len(history.BaseFee)-1]), nil
+}
+
+// mulFloat multiplies a wei amount by a float factor, rounding down
+func mulFloat(value *big.Int, factor float64) *big.Int {
+	result, _ := new(big.Float).Mul(new(big.Float).SetInt(value), big.NewFloat(factor)).Int(nil)
+	return result
+}
+
+// GweiToWei converts a gwei amount to wei
+func GweiToWei(gwei float64) *big.Int {
+	return mulFloat(big.NewInt(params.GWei), gwei)
+}
+
+func weiToGwei(wei *big.Int) string {
+	return new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.GWei)).Text('f', 4)
+}
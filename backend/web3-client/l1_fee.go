@@ -0,0 +1,106 @@
+package web3client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// GasPriceOracleAddress is the OP-stack GasPriceOracle predeploy on Base
+var GasPriceOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
+
+// gasPriceOracleABI is the subset of the OP-stack GasPriceOracle used by the backend
+const gasPriceOracleABI = `[
+	{"type":"function","name":"getL1Fee","stateMutability":"view","inputs":[{"name":"_data","type":"bytes"}],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+var gasPriceOracle = mustParseABI(gasPriceOracleABI)
+
+// weiPerEther converts wei amounts to ETH
+var weiPerEther = new(big.Float).SetInt(big.NewInt(1e18))
+
+// CostEstimate is the full cost of a transaction on Base: L2 execution plus the L1 data fee
+type CostEstimate struct {
+	GasLimit uint64
+	GasPrice *big.Int // Effective L2 gas price
+	L2FeeWei *big.Int
+	L1FeeWei *big.Int
+	TotalWei *big.Int
+	TotalUSD float64
+	L2FeeUSD float64
+	L1FeeUSD float64
+}
+
+// GetL1Fee returns the L1 data fee the GasPriceOracle charges for a serialized transaction
+func (cm *ContractManager) GetL1Fee(ctx context.Context, tx *types.Transaction) (*big.Int, error) {
+	serialized, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize transaction: %w", err)
+	}
+
+	fee, err := cm.callUint256(ctx, nil, gasPriceOracle, GasPriceOracleAddress, "getL1Fee", serialized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 fee: %w", err)
+	}
+
+	return fee, nil
+}
+
+// EstimateTotalCost estimates the total cost in wei and USD of sending a contract call,
+// including the L1 data fee. The transaction is built as it would be sent but not signed.
+func (cm *ContractManager) EstimateTotalCost(ctx context.Context, to common.Address, data []byte, ethPriceUSD float64) (*CostEstimate, error) {
+	gasLimit, err := cm.EstimateGas(ctx, to, data)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := cm.SuggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := cm.client.PendingNonceAt(ctx, cm.auth.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   new(big.Int).SetUint64(cm.artifacts.ChainID),
+		Nonce:     nonce,
+		GasTipCap: fees.GasTipCap,
+		GasFeeCap: fees.GasFeeCap,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     big.NewInt(0),
+		Data:      data,
+	})
+
+	l1Fee, err := cm.GetL1Fee(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := fees.EffectiveGasPrice()
+	l2Fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit))
+	total := new(big.Int).Add(l2Fee, l1Fee)
+
+	return &CostEstimate{
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+		L2FeeWei: l2Fee,
+		L1FeeWei: l1Fee,
+		TotalWei: total,
+		TotalUSD: WeiToUSD(total, ethPriceUSD),
+		L2FeeUSD: WeiToUSD(l2Fee, ethPriceUSD),
+		L1FeeUSD: WeiToUSD(l1Fee, ethPriceUSD),
+	}, nil
+}
+
+// WeiToUSD values a wei amount at the given ETH/USD price
+func WeiToUSD(wei *big.Int, ethPriceUSD float64) float64 {
+	eth, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), weiPerEther).Float64()
+	return eth * ethPriceUSD
+}
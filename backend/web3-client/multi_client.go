@@ -0,0 +1,724 @@
+package web3client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sirupsen/logrus"
+)
+
+// Backend is the RPC client used by ContractManager. It is
+// implemented by *ethclient.Client and *MultiClient.
+type Backend interface {
+	bind.ContractBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	Close()
+}
+
+// QuorumCaller is implemented by backends that can require several providers to
+// agree on the result of a read
+type QuorumCaller interface {
+	QuorumCallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// CommonBlockReader is implemented by backends that can name a recent block every
+// provider is able to serve
+type CommonBlockReader interface {
+	CommonBlockNumber(ctx context.Context) (uint64, error)
+}
+
+// MultiClientConfig configures endpoint health checks, failover and quorum reads
+type MultiClientConfig struct {
+	// HealthCheckInterval is the delay between background health checks, zero disables them
+	HealthCheckInterval time.Duration
+	// MaxBlockLag is how many blocks an endpoint may trail the highest one and stay healthy
+	MaxBlockLag uint64
+	// MaxLatency is the slowest eth_blockNumber response a healthy endpoint may have
+	MaxLatency time.Duration
+	// RequestTimeout bounds each attempt against a single endpoint
+	RequestTimeout time.Duration
+	// Quorum is the number of endpoints that must return identical results for quorum reads
+	Quorum int
+}
+
+// DefaultMultiClientConfig returns settings suited to Base's 2 second blocks
+func DefaultMultiClientConfig() MultiClientConfig {
+	return MultiClientConfig{
+		HealthCheckInterval: 15 * time.Second,
+		MaxBlockLag:         5,
+		MaxLatency:          2 * time.Second,
+		RequestTimeout:      10 * time.Second,
+		Quorum:              1,
+	}
+}
+
+// QuorumError is returned when too few endpoints agree on a quorum read
+type QuorumError struct {
+	Required  int
+	Agreeing  int
+	Responses int
+	Errors    []error
+}
+
+func (e *QuorumError) Error() string {
+	msg := fmt.Sprintf("quorum not reached: %d of %d responses agree, %d required", e.Agreeing, e.Responses, e.Required)
+	if len(e.Errors) > 0 {
+		msg += fmt.Sprintf(" (%d endpoints failed: %v)", len(e.Errors), errors.Join(e.Errors...))
+	}
+	return msg
+}
+
+// rpcEndpoint is one RPC provider and its last observed health
+type rpcEndpoint struct {
+	url    string
+	client *ethclient.Client
+
+	healthy     bool
+	blockNumber uint64
+	latency     time.Duration
+	lastErr     error
+}
+
+// EndpointStatus is a snapshot of an endpoint's health
+type EndpointStatus struct {
+	URL         string
+	Healthy     bool
+	BlockNumber uint64
+	Latency     time.Duration
+	Error       error
+}
+
+// MultiClient spreads RPC calls over several endpoints. Calls go to the first
+// healthy endpoint in configuration order and fail over to the next one on
+// transport errors; reads through QuorumCallContract require Quorum endpoints
+// to return the same result.
+type MultiClient struct {
+	mu        sync.RWMutex
+	endpoints []*rpcEndpoint
+	config    MultiClientConfig
+	logger    *logrus.Logger
+	stop      context.CancelFunc
+}
+
+// DialMultiClient connects to every URL, runs an initial health check and starts
+// background health checks. At least one endpoint must be healthy.
+func DialMultiClient(ctx context.Context, urls []string, config MultiClientConfig, logger *logrus.Logger) (*MultiClient, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("no RPC endpoints configured")
+	}
+	if config.Quorum < 1 {
+		config.Quorum = 1
+	}
+	if config.Quorum > len(urls) {
+		return nil, fmt.Errorf("quorum %d exceeds the %d configured RPC endpoints", config.Quorum, len(urls))
+	}
+
+	mc := &MultiClient{config: config, logger: logger}
+	for _, url := range urls {
+		client, err := ethclient.DialContext(ctx, url)
+		if err != nil {
+			mc.Close()
+			return nil, fmt.Errorf("failed to connect to %s: %w", redactURL(url), err)
+		}
+		mc.endpoints = append(mc.endpoints, &rpcEndpoint{url: url, client: client})
+	}
+
+	mc.CheckHealth(ctx)
+	if mc.healthyCount() == 0 {
+		statuses := mc.Status()
+		mc.Close()
+		return nil, fmt.Errorf("no healthy RPC endpoint: %s", describeStatuses(statuses))
+	}
+
+	if config.HealthCheckInterval > 0 {
+		monitorCtx, cancel := context.WithCancel(context.Background())
+		mc.stop = cancel
+		go mc.monitor(monitorCtx)
+	}
+
+	return mc, nil
+}
+
+// CheckHealth queries every endpoint's block height and latency and marks
+// endpoints unhealthy when they error, lag or respond too slowly
+func (mc *MultiClient) CheckHealth(ctx context.Context) {
+	type probe struct {
+		blockNumber uint64
+		latency     time.Duration
+		err         error
+	}
+
+	probes := make([]probe, len(mc.endpoints))
+	var wg sync.WaitGroup
+	for i, ep := range mc.endpoints {
+		wg.Add(1)
+		go func(i int, ep *rpcEndpoint) {
+			defer wg.Done()
+			attemptCtx, cancel := mc.attemptContext(ctx)
+			defer cancel()
+
+			start := time.Now()
+			blockNumber, err := ep.client.BlockNumber(attemptCtx)
+			probes[i] = probe{blockNumber: blockNumber, latency: time.Since(start), err: err}
+		}(i, ep)
+	}
+	wg.Wait()
+
+	var highest uint64
+	for _, p := range probes {
+		if p.err == nil && p.blockNumber > highest {
+			highest = p.blockNumber
+		}
+	}
+
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	for i, ep := range mc.endpoints {
+		p := probes[i]
+		wasHealthy := ep.healthy
+
+		ep.blockNumber, ep.latency, ep.lastErr = p.blockNumber, p.latency, p.err
+		switch {
+		case p.err != nil:
+		case highest-p.blockNumber > mc.config.MaxBlockLag:
+			ep.lastErr = fmt.Errorf("%d blocks behind the highest endpoint", highest-p.blockNumber)
+		case mc.config.MaxLatency > 0 && p.latency > mc.config.MaxLatency:
+			ep.lastErr = fmt.Errorf("latency %s exceeds %s", p.latency.Round(time.Millisecond), mc.config.MaxLatency)
+		}
+		ep.healthy = ep.lastErr == nil
+
+		if wasHealthy != ep.healthy {
+			entry := mc.logger.WithFields(logrus.Fields{
+				"endpoint":    redactURL(ep.url),
+				"blockNumber": ep.blockNumber,
+				"latency":     ep.latency.Round(time.Millisecond).String(),
+			})
+			if ep.healthy {
+				entry.Info("RPC endpoint healthy")
+			} else {
+				entry.WithError(ep.lastErr).Warn("RPC endpoint unhealthy")
+			}
+		}
+	}
+}
+
+// Status returns a snapshot of every endpoint's health
+func (mc *MultiClient) Status() []EndpointStatus {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	statuses := make([]EndpointStatus, len(mc.endpoints))
+	for i, ep := range mc.endpoints {
+		statuses[i] = EndpointStatus{
+			URL:         redactURL(ep.url),
+			Healthy:     ep.healthy,
+			BlockNumber: ep.blockNumber,
+			Latency:     ep.latency,
+			Error:       ep.lastErr,
+		}
+	}
+	return statuses
+}
+
+func (mc *MultiClient) monitor(ctx context.Context) {
+	ticker := time.NewTicker(mc.config.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			mc.CheckHealth(ctx)
+		}
+	}
+}
+
+func (mc *MultiClient) healthyCount() int {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	count := 0
+	for _, ep := range mc.endpoints {
+		if ep.healthy {
+			count++
+		}
+	}
+	return count
+}
+
+// ordered returns healthy endpoints in configuration order followed by unhealthy
+// ones, which are only tried as a last resort
+func (mc *MultiClient) ordered() []*rpcEndpoint {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	ordered := make([]*rpcEndpoint, 0, len(mc.endpoints))
+	for _, ep := range mc.endpoints {
+		if ep.healthy {
+			ordered = append(ordered, ep)
+		}
+	}
+	for _, ep := range mc.endpoints {
+		if !ep.healthy {
+			ordered = append(ordered, ep)
+		}
+	}
+	return ordered
+}
+
+// markFailed takes an endpoint out of rotation until the next successful health check
+func (mc *MultiClient) markFailed(ep *rpcEndpoint, err error) {
+	mc.mu.Lock()
+	wasHealthy := ep.healthy
+	ep.healthy = false
+	ep.lastErr = err
+	mc.mu.Unlock()
+
+	if wasHealthy {
+		mc.logger.WithField("endpoint", redactURL(ep.url)).WithError(err).Warn("RPC endpoint failed, failing over")
+	}
+}
+
+func (mc *MultiClient) attemptContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if mc.config.RequestTimeout > 0 {
+		return context.WithTimeout(ctx, mc.config.RequestTimeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// do runs fn against endpoints in order until one succeeds or returns an error
+// that another provider would return too
+func (mc *MultiClient) do(ctx context.Context, fn func(ctx context.Context, client *ethclient.Client) error) error {
+	var errs []error
+	for _, ep := range mc.ordered() {
+		attemptCtx, cancel := mc.attemptContext(ctx)
+		err := fn(attemptCtx, ep.client)
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil || !isFailoverError(err) {
+			return err
+		}
+
+		mc.markFailed(ep, err)
+		errs = append(errs, fmt.Errorf("%s: %w", redactURL(ep.url), err))
+	}
+	return fmt.Errorf("all RPC endpoints failed: %w", errors.Join(errs...))
+}
+
+// isFailoverError reports whether an error came from the provider rather than the
+// request, so retrying on another endpoint may succeed
+func isFailoverError(err error) bool {
+	if errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.ErrorCode() {
+		case -32603, -32005: // internal error, limit exceeded
+			return true
+		default:
+			// The node processed the request: reverts, nonce and fee errors
+			return false
+		}
+	}
+
+	// Transport failures, HTTP 429/5xx and per-attempt timeouts
+	return true
+}
+
+// isRevertError reports whether a call failed because the contract reverted
+func isRevertError(err error) bool {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		return true
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "execution reverted")
+}
+
+// QuorumCallContract executes a call against every healthy endpoint at the same
+// block and returns the result once Quorum of them agree byte for byte. When
+// blockNumber is nil the call is pinned to the lowest head among healthy endpoints
+// so providers at different heights compare the same state.
+func (mc *MultiClient) QuorumCallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if mc.config.Quorum <= 1 {
+		return mc.CallContract(ctx, call, blockNumber)
+	}
+
+	endpoints := mc.ordered()
+	if blockNumber == nil {
+		if number, ok := mc.lowestHealthyHead(); ok {
+			blockNumber = new(big.Int).SetUint64(number)
+		}
+	}
+
+	type response struct {
+		result []byte
+		err    error
+	}
+	responses := make([]response, len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, ep := range endpoints {
+		wg.Add(1)
+		go func(i int, ep *rpcEndpoint) {
+			defer wg.Done()
+			attemptCtx, cancel := mc.attemptContext(ctx)
+			defer cancel()
+
+			result, err := ep.client.CallContract(attemptCtx, call, blockNumber)
+			responses[i] = response{result: result, err: err}
+		}(i, ep)
+	}
+	wg.Wait()
+
+	quorumErr := &QuorumError{Required: mc.config.Quorum}
+	var results [][]byte
+	for i, r := range responses {
+		if r.err != nil {
+			if isRevertError(r.err) {
+				// A revert is the same on every honest provider
+				return nil, r.err
+			}
+			quorumErr.Errors = append(quorumErr.Errors, fmt.Errorf("%s: %w", redactURL(endpoints[i].url), r.err))
+			continue
+		}
+		results = append(results, r.result)
+	}
+	quorumErr.Responses = len(results)
+
+	for _, candidate := range results {
+		agreeing := 0
+		for _, other := range results {
+			if bytes.Equal(candidate, other) {
+				agreeing++
+			}
+		}
+		if agreeing >= mc.config.Quorum {
+			return candidate, nil
+		}
+		if agreeing > quorumErr.Agreeing {
+			quorumErr.Agreeing = agreeing
+		}
+	}
+
+	mc.logger.WithFields(logrus.Fields{
+		"to":          call.To,
+		"blockNumber": blockNumber,
+		"responses":   quorumErr.Responses,
+		"agreeing":    quorumErr.Agreeing,
+	}).Warn("RPC endpoints disagree on quorum read")
+
+	return nil, quorumErr
+}
+
+// CodeAt implements bind.ContractCaller
+func (mc *MultiClient) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (code []byte, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		code, err = c.CodeAt(ctx, contract, blockNumber)
+		return err
+	})
+	return code, err
+}
+
+// CallContract implements bind.ContractCaller
+func (mc *MultiClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		result, err = c.CallContract(ctx, call, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+// HeaderByNumber implements bind.ContractTransactor
+func (mc *MultiClient) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		header, err = c.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+// PendingCodeAt implements bind.ContractTransactor
+func (mc *MultiClient) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		code, err = c.PendingCodeAt(ctx, account)
+		return err
+	})
+	return code, err
+}
+
+// PendingNonceAt implements bind.ContractTransactor
+func (mc *MultiClient) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		nonce, err = c.PendingNonceAt(ctx, account)
+		return err
+	})
+	return nonce, err
+}
+
+// SuggestGasPrice implements bind.ContractTransactor
+func (mc *MultiClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		price, err = c.SuggestGasPrice(ctx)
+		return err
+	})
+	return price, err
+}
+
+// SuggestGasTipCap implements bind.ContractTransactor
+func (mc *MultiClient) SuggestGasTipCap(ctx context.Context) (tip *big.Int, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		tip, err = c.SuggestGasTipCap(ctx)
+		return err
+	})
+	return tip, err
+}
+
+// EstimateGas implements bind.ContractTransactor
+func (mc *MultiClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		gas, err = c.EstimateGas(ctx, call)
+		return err
+	})
+	return gas, err
+}
+
+// SendTransaction implements bind.ContractTransactor. If an endpoint fails after
+// possibly accepting the transaction, a later endpoint reporting it as already
+// known counts as success.
+func (mc *MultiClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	attempts := 0
+	return mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		attempts++
+		err := c.SendTransaction(ctx, tx)
+		if err != nil && attempts > 1 && strings.Contains(strings.ToLower(err.Error()), "already known") {
+			return nil
+		}
+		return err
+	})
+}
+
+// FilterLogs implements bind.ContractFilterer
+func (mc *MultiClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		logs, err = c.FilterLogs(ctx, query)
+		return err
+	})
+	return logs, err
+}
+
+// SubscribeFilterLogs implements bind.ContractFilterer. The subscription stays on
+// the endpoint that accepted it.
+func (mc *MultiClient) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+	for _, ep := range mc.ordered() {
+		sub, err = ep.client.SubscribeFilterLogs(ctx, query, ch)
+		if err == nil {
+			return sub, nil
+		}
+	}
+	return nil, err
+}
+
+// CommonBlockNumber returns the lowest head among healthy endpoints at the last
+// health check, so every healthy endpoint can serve reads at it. It falls back to
+// BlockNumber when no endpoint is healthy.
+func (mc *MultiClient) CommonBlockNumber(ctx context.Context) (uint64, error) {
+	if number, ok := mc.lowestHealthyHead(); ok {
+		return number, nil
+	}
+	return mc.BlockNumber(ctx)
+}
+
+func (mc *MultiClient) lowestHealthyHead() (number uint64, ok bool) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	for _, ep := range mc.endpoints {
+		if ep.healthy && (!ok || ep.blockNumber < number) {
+			number, ok = ep.blockNumber, true
+		}
+	}
+	return number, ok
+}
+
+// ChainID returns the chain ID reported by the first healthy endpoint
+func (mc *MultiClient) ChainID(ctx context.Context) (chainID *big.Int, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		chainID, err = c.ChainID(ctx)
+		return err
+	})
+	return chainID, err
+}
+
+// EndpointChainID is the chain ID an endpoint reported, or why it could not be read
+type EndpointChainID struct {
+	URL     string
+	ChainID *big.Int
+	Err     error
+}
+
+// ChainIDs queries eth_chainId on every configured endpoint, healthy or not, since any
+// of them may be failed over to later
+func (mc *MultiClient) ChainIDs(ctx context.Context) []EndpointChainID {
+	chainIDs := make([]EndpointChainID, len(mc.endpoints))
+	var wg sync.WaitGroup
+	for i, ep := range mc.endpoints {
+		wg.Add(1)
+		go func(i int, ep *rpcEndpoint) {
+			defer wg.Done()
+			attemptCtx, cancel := mc.attemptContext(ctx)
+			defer cancel()
+
+			chainID, err := ep.client.ChainID(attemptCtx)
+			chainIDs[i] = EndpointChainID{URL: redactURL(ep.url), ChainID: chainID, Err: err}
+		}(i, ep)
+	}
+	wg.Wait()
+	return chainIDs
+}
+
+// BlockNumber returns the most recent block number
+func (mc *MultiClient) BlockNumber(ctx context.Context) (number uint64, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		number, err = c.BlockNumber(ctx)
+		return err
+	})
+	return number, err
+}
+
+// BlockRef identifies a block by the hash the RPC endpoint reports for it
+type BlockRef struct {
+	Number     uint64
+	Hash       common.Hash
+	ParentHash common.Hash
+	Time       uint64
+}
+
+// BlockRef returns the number, hash, parent hash and timestamp of a block. Unlike
+// HeaderByNumber(...).Hash(), the hash does not depend on go-ethereum knowing every
+// header field the chain has added.
+func (mc *MultiClient) BlockRef(ctx context.Context, number uint64) (ref *BlockRef, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		var block *struct {
+			Number     hexutil.Uint64 `json:"number"`
+			Hash       common.Hash    `json:"hash"`
+			ParentHash common.Hash    `json:"parentHash"`
+			Time       hexutil.Uint64 `json:"timestamp"`
+		}
+		if err := c.Client().CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.EncodeUint64(number), false); err != nil {
+			return err
+		}
+		if block == nil {
+			return ethereum.NotFound
+		}
+		ref = &BlockRef{
+			Number:     uint64(block.Number),
+			Hash:       block.Hash,
+			ParentHash: block.ParentHash,
+			Time:       uint64(block.Time),
+		}
+		return nil
+	})
+	return ref, err
+}
+
+// NonceAt returns the account nonce at the given block
+func (mc *MultiClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		nonce, err = c.NonceAt(ctx, account, blockNumber)
+		return err
+	})
+	return nonce, err
+}
+
+// BalanceAt returns the account balance at the given block
+func (mc *MultiClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		balance, err = c.BalanceAt(ctx, account, blockNumber)
+		return err
+	})
+	return balance, err
+}
+
+// TransactionByHash returns the transaction with the given hash
+func (mc *MultiClient) TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		tx, isPending, err = c.TransactionByHash(ctx, hash)
+		return err
+	})
+	return tx, isPending, err
+}
+
+// TransactionReceipt returns the receipt of a mined transaction
+func (mc *MultiClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		receipt, err = c.TransactionReceipt(ctx, txHash)
+		return err
+	})
+	return receipt, err
+}
+
+// FeeHistory returns fee history for the given block range
+func (mc *MultiClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (history *ethereum.FeeHistory, err error) {
+	err = mc.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		history, err = c.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+		return err
+	})
+	return history, err
+}
+
+// Close stops health checks and closes every endpoint
+func (mc *MultiClient) Close() {
+	if mc.stop != nil {
+		mc.stop()
+	}
+	for _, ep := range mc.endpoints {
+		ep.client.Close()
+	}
+}
+
+// redactURL strips credentials and API-key paths from an RPC URL for logging
+func redactURL(raw string) string {
+	scheme, rest, ok := strings.Cut(raw, "://")
+	if !ok {
+		return raw
+	}
+	if at := strings.LastIndex(rest, "@"); at >= 0 {
+		rest = rest[at+1:]
+	}
+	host, _, _ := strings.Cut(rest, "/")
+	return scheme + "://" + host
+}
+
+func describeStatuses(statuses []EndpointStatus) string {
+	parts := make([]string, len(statuses))
+	for i, s := range statuses {
+		parts[i] = fmt.Sprintf("%s: %v", s.URL, s.Error)
+	}
+	return strings.Join(parts, "; ")
+}
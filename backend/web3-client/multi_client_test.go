@@ -0,0 +1,327 @@
+package web3client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/sirupsen/logrus"
+)
+
+// rpcNode is a JSON-RPC stand-in for one provider. It reports head as its latest
+// block and chainID as its chain (Base by default), and answers eth_call with result,
+// an HTTP status or a JSON-RPC error, and records the block every eth_call asked for.
+type rpcNode struct {
+	head    uint64
+	chainID uint64
+	result  []byte
+	status  int
+	errCode int
+
+	mu     sync.Mutex
+	blocks []string
+}
+
+func (n *rpcNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	switch req.Method {
+	case "eth_blockNumber":
+		resp["result"] = hexutil.EncodeUint64(n.head)
+	case "eth_chainId":
+		if n.status != 0 {
+			w.WriteHeader(n.status)
+			return
+		}
+		chainID := n.chainID
+		if chainID == 0 {
+			chainID = 8453
+		}
+		resp["result"] = hexutil.EncodeUint64(chainID)
+	case "eth_call":
+		var block string
+		if len(req.Params) > 1 {
+			json.Unmarshal(req.Params[1], &block)
+		}
+		n.mu.Lock()
+		n.blocks = append(n.blocks, block)
+		n.mu.Unlock()
+
+		switch {
+		case n.status != 0:
+			w.WriteHeader(n.status)
+			return
+		case n.errCode == 3:
+			resp["error"] = map[string]interface{}{"code": 3, "message": "execution reverted", "data": "0x"}
+		case n.errCode != 0:
+			resp["error"] = map[string]interface{}{"code": n.errCode, "message": "internal error"}
+		default:
+			resp["result"] = hexutil.Encode(n.result)
+		}
+	default:
+		resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (n *rpcNode) calledBlocks() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]string(nil), n.blocks...)
+}
+
+// dialNodes serves each node over HTTP and connects a MultiClient to them in order
+func dialNodes(t *testing.T, quorum int, nodes ...*rpcNode) *MultiClient {
+	t.Helper()
+	urls := make([]string, len(nodes))
+	for i, node := range nodes {
+		server := httptest.NewServer(node)
+		t.Cleanup(server.Close)
+		urls[i] = server.URL
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	mc, err := DialMultiClient(context.Background(), urls, MultiClientConfig{
+		MaxBlockLag:    5,
+		RequestTimeout: time.Second,
+		Quorum:         quorum,
+	}, logger)
+	if err != nil {
+		t.Fatalf("DialMultiClient: %v", err)
+	}
+	t.Cleanup(mc.Close)
+	return mc
+}
+
+func word(value int64) []byte {
+	return common.LeftPadBytes(big.NewInt(value).Bytes(), 32)
+}
+
+func TestMultiClientFailover(t *testing.T) {
+	tests := []struct {
+		name     string
+		first    *rpcNode
+		failover bool
+	}{
+		{"http 503", &rpcNode{head: 100, status: http.StatusServiceUnavailable}, true},
+		{"internal error", &rpcNode{head: 100, errCode: -32603}, true},
+		{"revert", &rpcNode{head: 100, errCode: 3}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			second := &rpcNode{head: 100, result: word(42)}
+			mc := dialNodes(t, 1, tt.first, second)
+
+			result, err := mc.CallContract(context.Background(), ethereum.CallMsg{}, nil)
+			if !tt.failover {
+				if err == nil {
+					t.Fatal("CallContract succeeded, want the revert")
+				}
+				if calls := len(second.calledBlocks()); calls != 0 {
+					t.Errorf("second endpoint called %d times, want 0", calls)
+				}
+				if !mc.Status()[0].Healthy {
+					t.Error("first endpoint marked unhealthy after a revert")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("CallContract: %v", err)
+			}
+			if new(big.Int).SetBytes(result).Int64() != 42 {
+				t.Errorf("result = %x, want the second endpoint's", result)
+			}
+			if mc.Status()[0].Healthy {
+				t.Error("first endpoint still healthy after failing")
+			}
+
+			// The failed endpoint is only tried again after the healthy ones
+			if _, err := mc.CallContract(context.Background(), ethereum.CallMsg{}, nil); err != nil {
+				t.Fatalf("second CallContract: %v", err)
+			}
+			if calls := len(tt.first.calledBlocks()); calls != 1 {
+				t.Errorf("failed endpoint called %d times, want 1", calls)
+			}
+		})
+	}
+}
+
+func TestQuorumCallContract(t *testing.T) {
+	failing := func(head uint64) *rpcNode { return &rpcNode{head: head, status: http.StatusBadGateway} }
+
+	tests := []struct {
+		name      string
+		nodes     []*rpcNode
+		want      int64
+		agreeing  int
+		responses int
+		failed    int
+	}{
+		{
+			name:  "two of three agree",
+			nodes: []*rpcNode{{head: 100, result: word(7)}, {head: 99, result: word(8)}, {head: 101, result: word(7)}},
+			want:  7,
+		},
+		{
+			name:  "failed endpoint",
+			nodes: []*rpcNode{failing(100), {head: 99, result: word(7)}, {head: 101, result: word(7)}},
+			want:  7,
+		},
+		{
+			name:      "all disagree",
+			nodes:     []*rpcNode{{head: 100, result: word(7)}, {head: 99, result: word(8)}, {head: 101, result: word(9)}},
+			agreeing:  1,
+			responses: 3,
+		},
+		{
+			name:      "survivors disagree",
+			nodes:     []*rpcNode{failing(100), {head: 99, result: word(7)}, {head: 101, result: word(8)}},
+			agreeing:  1,
+			responses: 2,
+			failed:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := dialNodes(t, 2, tt.nodes...)
+
+			result, err := mc.QuorumCallContract(context.Background(), ethereum.CallMsg{}, nil)
+			if tt.want != 0 {
+				if err != nil {
+					t.Fatalf("QuorumCallContract: %v", err)
+				}
+				if got := new(big.Int).SetBytes(result).Int64(); got != tt.want {
+					t.Errorf("result = %d, want %d", got, tt.want)
+				}
+			} else {
+				var quorumErr *QuorumError
+				if !errors.As(err, &quorumErr) {
+					t.Fatalf("error = %v, want *QuorumError", err)
+				}
+				if quorumErr.Required != 2 || quorumErr.Agreeing != tt.agreeing || quorumErr.Responses != tt.responses || len(quorumErr.Errors) != tt.failed {
+					t.Errorf("QuorumError = %+v, want 2 required, %d agreeing, %d responses, %d failed",
+						quorumErr, tt.agreeing, tt.responses, tt.failed)
+				}
+			}
+
+			// Every endpoint is asked for the lowest healthy head
+			for i, node := range tt.nodes {
+				if blocks := node.calledBlocks(); len(blocks) != 1 || blocks[0] != "0x63" {
+					t.Errorf("endpoint %d called at blocks %v, want [0x63]", i, blocks)
+				}
+			}
+		})
+	}
+}
+
+func TestPortfolioReadsPinnedToOneBlock(t *testing.T) {
+	nodes := []*rpcNode{
+		{head: 100, result: word(1000)},
+		{head: 97, result: word(1000)},
+		{head: 101, result: word(1000)},
+	}
+	mc := dialNodes(t, 2, nodes...)
+	cm := &ContractManager{
+		client:    mc,
+		artifacts: &DeploymentArtifacts{ControllerProxy: common.HexToAddress("0x1")},
+		logger:    mc.logger,
+	}
+
+	ctx := context.Background()
+	blockNumber, err := cm.ReadBlock(ctx)
+	if err != nil {
+		t.Fatalf("ReadBlock: %v", err)
+	}
+	if blockNumber.Uint64() != 97 {
+		t.Fatalf("block = %v, want the lowest healthy head 97", blockNumber)
+	}
+
+	// A provider moving ahead between reads must not change the block they use
+	nodes[1].head = 105
+	mc.CheckHealth(ctx)
+
+	if _, err := cm.GetTotalAssets(ctx, blockNumber); err != nil {
+		t.Fatalf("GetTotalAssets: %v", err)
+	}
+	if _, err := cm.GetStrategyAllocation(ctx, common.HexToAddress("0x2"), blockNumber); err != nil {
+		t.Fatalf("GetStrategyAllocation: %v", err)
+	}
+	if _, err := cm.GetStrategyAPY(ctx, common.HexToAddress("0x2"), blockNumber); err != nil {
+		t.Fatalf("GetStrategyAPY: %v", err)
+	}
+
+	calls := 0
+	for i, node := range nodes {
+		for _, block := range node.calledBlocks() {
+			calls++
+			if block != "0x61" {
+				t.Errorf("endpoint %d called at block %s, want 0x61", i, block)
+			}
+		}
+	}
+	if calls != 7 {
+		t.Errorf("eth_call requests = %d, want 7 (three per quorum read, one for the APY)", calls)
+	}
+}
+
+func TestPreflightChecksEveryEndpointChainID(t *testing.T) {
+	tests := []struct {
+		name    string
+		nodes   []*rpcNode
+		failing []int
+	}{
+		{"all on base", []*rpcNode{{head: 100}, {head: 100}}, nil},
+		{"secondary on mainnet", []*rpcNode{{head: 100}, {head: 100, chainID: 1}}, []int{1}},
+		{"secondary unreachable", []*rpcNode{{head: 100}, {head: 100, status: http.StatusBadGateway}}, []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := dialNodes(t, 1, tt.nodes...)
+			cm := &ContractManager{
+				client:    mc,
+				artifacts: &DeploymentArtifacts{Network: "base", ChainID: 8453},
+				logger:    mc.logger,
+			}
+
+			report := &PreflightReport{}
+			passed := cm.checkChainIDs(context.Background(), report)
+			if passed != (len(tt.failing) == 0) {
+				t.Errorf("checkChainIDs = %v with failures %v", passed, report.Failures())
+			}
+			if len(report.Checks) != len(tt.nodes) {
+				t.Fatalf("checks = %d, want one per endpoint", len(report.Checks))
+			}
+			for _, i := range tt.failing {
+				if report.Checks[i].Passed {
+					t.Errorf("endpoint %d passed: %s", i, report.Checks[i].Detail)
+				}
+			}
+		})
+	}
+}
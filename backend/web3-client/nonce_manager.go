@@ -0,0 +1,424 @@
+package web3client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+)
+
+// priceBumpPercent is the minimum fee increase nodes accept for a same-nonce
+// replacement (geth's txpool.pricebump default)
+const priceBumpPercent = 10
+
+// nonceBackend is the subset of the RPC client used by NonceManager
+type nonceBackend interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// pendingTx is a submitted transaction that has not been mined yet
+type pendingTx struct {
+	tx          *types.Transaction
+	submittedAt time.Time
+	bumps       int
+}
+
+// NonceManager hands out nonces for the keeper account locally so several
+// transactions can be in flight, and replaces transactions that stay pending too long
+type NonceManager struct {
+	mu         sync.Mutex
+	replacing  sync.Mutex // Serializes ReplaceStuck
+	client     nonceBackend
+	address    common.Address
+	signer     bind.SignerFn
+	stuckAfter time.Duration
+	maxFee     *big.Int
+	logger     *logrus.Logger
+
+	next       uint64
+	synced     bool
+	pending    map[uint64]*pendingTx
+	replacedBy map[common.Hash]common.Hash
+}
+
+// NewNonceManager creates a nonce manager for an account. Replacement fees are capped
+// at maxFee (MAX_FEE_CEILING_GWEI); a nil or zero ceiling disables the cap.
+func NewNonceManager(client nonceBackend, address common.Address, signer bind.SignerFn, stuckAfter time.Duration, maxFee *big.Int, logger *logrus.Logger) *NonceManager {
+	return &NonceManager{
+		client:     client,
+		address:    address,
+		signer:     signer,
+		stuckAfter: stuckAfter,
+		maxFee:     maxFee,
+		logger:     logger,
+		pending:    make(map[uint64]*pendingTx),
+		replacedBy: make(map[common.Hash]common.Hash),
+	}
+}
+
+// SetStuckAfter changes how long a transaction may stay pending before it is replaced
+func (nm *NonceManager) SetStuckAfter(stuckAfter time.Duration) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+	nm.stuckAfter = stuckAfter
+}
+
+// SetMaxFee changes the ceiling on replacement fees. A nil or zero ceiling disables it.
+func (nm *NonceManager) SetMaxFee(maxFee *big.Int) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+	nm.maxFee = maxFee
+}
+
+// Next reserves and returns the next nonce, syncing with the chain on first use
+func (nm *NonceManager) Next(ctx context.Context) (uint64, error) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	if !nm.synced {
+		if err := nm.syncLocked(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	nonce := nm.next
+	nm.next++
+	return nonce, nil
+}
+
+// Release returns a reserved nonce whose transaction was never accepted by the node.
+// If other nonces were reserved after it, the manager resyncs with the chain on next use.
+func (nm *NonceManager) Release(nonce uint64) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	if nm.synced && nonce+1 == nm.next {
+		nm.next = nonce
+		return
+	}
+	nm.synced = false
+}
+
+// Resync discards the local nonce and reloads it from the chain's pending state
+func (nm *NonceManager) Resync(ctx context.Context) error {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+	return nm.syncLocked(ctx)
+}
+
+func (nm *NonceManager) syncLocked(ctx context.Context) error {
+	nonce, err := nm.client.PendingNonceAt(ctx, nm.address)
+	if err != nil {
+		return fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	// Never hand out a nonce below one we are still tracking
+	for pendingNonce := range nm.pending {
+		if pendingNonce >= nonce {
+			nonce = pendingNonce + 1
+		}
+	}
+
+	nm.next = nonce
+	nm.synced = true
+	return nil
+}
+
+// HandleSendError updates local state after the node rejected a transaction
+func (nm *NonceManager) HandleSendError(nonce uint64, err error) {
+	if isNonceError(err) {
+		nm.mu.Lock()
+		nm.synced = false
+		nm.mu.Unlock()
+
+		nm.logger.WithField("nonce", nonce).WithError(err).Warn("Nonce rejected by node, resyncing")
+		return
+	}
+
+	nm.Release(nonce)
+}
+
+// Track records a submitted transaction so it can be replaced if it gets stuck
+func (nm *NonceManager) Track(tx *types.Transaction) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	nm.pending[tx.Nonce()] = &pendingTx{
+		tx:          tx,
+		submittedAt: time.Now(),
+	}
+}
+
+// ReplacementFor returns the latest transaction that replaced the given hash, if any
+func (nm *NonceManager) ReplacementFor(hash common.Hash) (common.Hash, bool) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	replacement, ok := nm.replacedBy[hash]
+	if !ok {
+		return common.Hash{}, false
+	}
+	for {
+		next, ok := nm.replacedBy[replacement]
+		if !ok {
+			return replacement, true
+		}
+		replacement = next
+	}
+}
+
+// ReplaceStuck drops transactions that have been mined and resubmits those pending
+// longer than the stuck deadline at the same nonce with bumped fees. Replacement stops
+// with a FeeCeilingError once the max-fee ceiling leaves no room for a valid bump.
+func (nm *NonceManager) ReplaceStuck(ctx context.Context) ([]*types.Transaction, error) {
+	nm.replacing.Lock()
+	defer nm.replacing.Unlock()
+
+	confirmed, err := nm.client.NonceAt(ctx, nm.address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get confirmed nonce: %w", err)
+	}
+
+	// Copy the stuck entries so the lock is not held across RPC calls and signing
+	nm.mu.Lock()
+	var stuck []pendingTx
+	for nonce, entry := range nm.pending {
+		if nonce < confirmed {
+			delete(nm.pending, nonce)
+			continue
+		}
+		if time.Since(entry.submittedAt) >= nm.stuckAfter {
+			stuck = append(stuck, *entry)
+		}
+	}
+	maxFee := nm.maxFee
+	nm.mu.Unlock()
+	sort.Slice(stuck, func(i, j int) bool { return stuck[i].tx.Nonce() < stuck[j].tx.Nonce() })
+
+	var replaced []*types.Transaction
+	for _, entry := range stuck {
+		nonce := entry.tx.Nonce()
+
+		replacement, err := nm.bumpFees(ctx, entry.tx, entry.bumps+1, maxFee)
+		if err != nil {
+			var ceilingErr *FeeCeilingError
+			if errors.As(err, &ceilingErr) {
+				nm.logger.WithFields(logrus.Fields{
+					"nonce":  nonce,
+					"txHash": entry.tx.Hash().Hex(),
+				}).WithError(err).Warn("Cannot replace stuck transaction within the fee ceiling, leaving it pending")
+			}
+			return replaced, err
+		}
+
+		signed, err := nm.signer(nm.address, replacement)
+		if err != nil {
+			return replaced, fmt.Errorf("failed to sign replacement: %w", err)
+		}
+
+		if err := nm.client.SendTransaction(ctx, signed); err != nil {
+			if isNonceTooLowError(err) {
+				// The original was mined in the meantime
+				nm.update(entry.tx, func() { delete(nm.pending, nonce) })
+				continue
+			}
+			if isNonceTooHighError(err) {
+				// A gap below this nonce, not inclusion: keep tracking the original and
+				// reload the nonce from the chain
+				nm.logger.WithField("nonce", nonce).WithError(err).Warn("Replacement nonce rejected as too high, resyncing")
+				if err := nm.Resync(ctx); err != nil {
+					return replaced, err
+				}
+				continue
+			}
+			if isUnderpricedError(err) {
+				// Bump harder on the next attempt
+				nm.update(entry.tx, func() { nm.pending[nonce].bumps++ })
+				nm.logger.WithField("nonce", nonce).WithError(err).Warn("Replacement underpriced, will retry with a larger bump")
+				continue
+			}
+			return replaced, fmt.Errorf("failed to send replacement for nonce %d: %w", nonce, err)
+		}
+
+		nm.logger.WithFields(logrus.Fields{
+			"nonce":    nonce,
+			"original": entry.tx.Hash().Hex(),
+			"txHash":   signed.Hash().Hex(),
+			"pending":  time.Since(entry.submittedAt).String(),
+		}).Warn("Replaced stuck transaction with higher fees")
+
+		nm.mu.Lock()
+		nm.replacedBy[entry.tx.Hash()] = signed.Hash()
+		nm.mu.Unlock()
+		nm.update(entry.tx, func() { nm.pending[nonce] = &pendingTx{tx: signed, submittedAt: time.Now()} })
+		replaced = append(replaced, signed)
+	}
+
+	return replaced, nil
+}
+
+// update applies fn under the lock if tx is still the pending transaction at its
+// nonce, so entries tracked while the lock was released are left alone
+func (nm *NonceManager) update(tx *types.Transaction, fn func()) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	if entry, ok := nm.pending[tx.Nonce()]; ok && entry.tx.Hash() == tx.Hash() {
+		fn()
+	}
+}
+
+// Monitor calls ReplaceStuck periodically until the context is done
+func (nm *NonceManager) Monitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := nm.ReplaceStuck(ctx); err != nil {
+				nm.logger.WithError(err).Error("Failed to replace stuck transactions")
+			}
+		}
+	}
+}
+
+// bumpFees returns a copy of tx with fees raised enough to replace it in the
+// mempool, and at least the network's current suggestion. Fees are clamped to the
+// max-fee ceiling; a FeeCeilingError is returned when the clamped fees no longer
+// satisfy the replacement rule.
+func (nm *NonceManager) bumpFees(ctx context.Context, tx *types.Transaction, bumps int, ceiling *big.Int) (*types.Transaction, error) {
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		suggestedTip, err := nm.client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gas tip: %w", err)
+		}
+
+		tip := maxBig(bumpPrice(tx.GasTipCap(), bumps), suggestedTip)
+		feeCap := bumpPrice(tx.GasFeeCap(), bumps)
+		if feeCap.Cmp(tip) < 0 {
+			feeCap = new(big.Int).Set(tip)
+		}
+		feeCap = clampFee(feeCap, ceiling)
+		tip = minBig(tip, feeCap)
+		if err := checkReplacement(tx.GasFeeCap(), feeCap, ceiling); err != nil {
+			return nil, err
+		}
+		if err := checkReplacement(tx.GasTipCap(), tip, ceiling); err != nil {
+			return nil, err
+		}
+
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			GasTipCap: tip,
+			GasFeeCap: feeCap,
+			Gas:       tx.Gas(),
+			To:        tx.To(),
+			Value:     tx.Value(),
+			Data:      tx.Data(),
+		}), nil
+	case types.LegacyTxType:
+		suggested, err := nm.client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gas price: %w", err)
+		}
+
+		price := clampFee(maxBig(bumpPrice(tx.GasPrice(), bumps), suggested), ceiling)
+		if err := checkReplacement(tx.GasPrice(), price, ceiling); err != nil {
+			return nil, err
+		}
+
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: price,
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}), nil
+	default:
+		return nil, fmt.Errorf("cannot replace transaction type %d", tx.Type())
+	}
+}
+
+// clampFee lowers a fee to the ceiling. A nil or zero ceiling disables it.
+func clampFee(fee, ceiling *big.Int) *big.Int {
+	if ceiling == nil || ceiling.Sign() <= 0 {
+		return fee
+	}
+	return minBig(fee, ceiling)
+}
+
+// checkReplacement returns a FeeCeilingError when a clamped fee is no longer the
+// priceBumpPercent increase over the original that nodes require for a replacement
+func checkReplacement(original, fee, ceiling *big.Int) error {
+	required := bumpPrice(original, 1)
+	if fee.Cmp(required) >= 0 || ceiling == nil {
+		return nil
+	}
+	return &FeeCeilingError{Required: required, Ceiling: ceiling}
+}
+
+// bumpPrice raises a price by priceBumpPercent, compounded once per bump, rounding up
+func bumpPrice(price *big.Int, bumps int) *big.Int {
+	bumped := new(big.Int).Set(price)
+	for i := 0; i < bumps; i++ {
+		bumped.Mul(bumped, big.NewInt(100+priceBumpPercent))
+		bumped.Add(bumped, big.NewInt(99))
+		bumped.Div(bumped, big.NewInt(100))
+	}
+	return bumped
+}
+
+func maxBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return new(big.Int).Set(b)
+}
+
+func minBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) <= 0 {
+		return a
+	}
+	return new(big.Int).Set(b)
+}
+
+// isNonceError reports whether the node rejected a transaction because of its nonce
+func isNonceError(err error) bool {
+	return isNonceTooLowError(err) || isNonceTooHighError(err)
+}
+
+// isNonceTooLowError reports whether the nonce was already used by a mined transaction
+func isNonceTooLowError(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "nonce too low")
+}
+
+// isNonceTooHighError reports whether the nonce is ahead of the account's next nonce
+func isNonceTooHighError(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "nonce too high")
+}
+
+// isUnderpricedError reports whether a replacement did not raise fees enough
+func isUnderpricedError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "replacement transaction underpriced") ||
+		strings.Contains(msg, "underpriced")
+}
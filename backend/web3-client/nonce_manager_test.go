@@ -0,0 +1,389 @@
+package web3client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/sirupsen/logrus"
+)
+
+// simulatedChain is an in-memory chain behind geth's transaction pool, so sends get
+// the nonce and replacement errors a live node returns. Blocks are only produced by
+// mine; until then transactions stay pending. It implements nonceBackend.
+type simulatedChain struct {
+	db      ethdb.Database
+	chain   *core.BlockChain
+	pool    *txpool.TxPool
+	address common.Address
+	chainID *big.Int
+	signer  bind.SignerFn
+}
+
+func newSimulatedChain(t *testing.T) *simulatedChain {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	genesis := &core.Genesis{
+		Config:   params.AllEthashProtocolChanges,
+		Alloc:    core.GenesisAlloc{address: {Balance: new(big.Int).Lsh(big.NewInt(1), 100)}},
+		GasLimit: 30_000_000,
+		BaseFee:  big.NewInt(params.InitialBaseFee),
+	}
+	db := rawdb.NewMemoryDatabase()
+	// Archive mode writes every block's state, which GenerateChain builds on
+	cacheConfig := *core.DefaultCacheConfigWithScheme(rawdb.HashScheme)
+	cacheConfig.TrieDirtyDisabled = true
+	chain, err := core.NewBlockChain(db, &cacheConfig, genesis, nil, ethash.NewFaker(), vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("NewBlockChain: %v", err)
+	}
+	t.Cleanup(chain.Stop)
+
+	poolConfig := legacypool.DefaultConfig
+	poolConfig.Journal = ""
+	pool, err := txpool.New(new(big.Int).SetUint64(poolConfig.PriceLimit), chain, []txpool.SubPool{legacypool.New(poolConfig, chain)})
+	if err != nil {
+		t.Fatalf("txpool.New: %v", err)
+	}
+	t.Cleanup(func() { pool.Close() })
+
+	opts, err := bind.NewKeyedTransactorWithChainID(key, genesis.Config.ChainID)
+	if err != nil {
+		t.Fatalf("NewKeyedTransactorWithChainID: %v", err)
+	}
+
+	return &simulatedChain{
+		db:      db,
+		chain:   chain,
+		pool:    pool,
+		address: address,
+		chainID: genesis.Config.ChainID,
+		signer:  opts.Signer,
+	}
+}
+
+func (c *simulatedChain) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return c.pool.Nonce(account), nil
+}
+
+func (c *simulatedChain) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	state, err := c.chain.State()
+	if err != nil {
+		return 0, err
+	}
+	return state.GetNonce(account), nil
+}
+
+func (c *simulatedChain) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return GweiToWei(1), nil
+}
+
+func (c *simulatedChain) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return GweiToWei(1), nil
+}
+
+func (c *simulatedChain) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return c.pool.Add([]*types.Transaction{tx}, true, true)[0]
+}
+
+// mine includes every executable pool transaction in a new block and waits for the
+// pool to drop them
+func (c *simulatedChain) mine(t *testing.T) {
+	t.Helper()
+	var txs []*types.Transaction
+	for _, lazy := range c.pool.Pending(false)[c.address] {
+		txs = append(txs, lazy.Resolve())
+	}
+
+	parent := c.chain.GetBlockByHash(c.chain.CurrentBlock().Hash())
+	blocks, _ := core.GenerateChain(c.chain.Config(), parent, ethash.NewFaker(), c.db, 1, func(_ int, block *core.BlockGen) {
+		for _, tx := range txs {
+			block.AddTx(tx)
+		}
+	})
+	if _, err := c.chain.InsertChain(blocks); err != nil {
+		t.Fatalf("InsertChain: %v", err)
+	}
+	waitFor(t, "the pool to drop mined transactions", func() bool {
+		return len(c.pool.Pending(false)[c.address]) == 0
+	})
+}
+
+// transfer signs a 21000 gas self-transfer with the given fees in gwei
+func (c *simulatedChain) transfer(t *testing.T, nonce uint64, tipGwei, feeCapGwei float64) *types.Transaction {
+	t.Helper()
+	tx, err := c.signer(c.address, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   c.chainID,
+		Nonce:     nonce,
+		GasTipCap: GweiToWei(tipGwei),
+		GasFeeCap: GweiToWei(feeCapGwei),
+		Gas:       21000,
+		To:        &c.address,
+	}))
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return tx
+}
+
+func (c *simulatedChain) nonceManager(stuckAfter time.Duration) *NonceManager {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return NewNonceManager(c, c.address, c.signer, stuckAfter, nil, logger)
+}
+
+// waitFor polls cond until it holds or the deadline passes
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestNonceManagerConcurrentSends(t *testing.T) {
+	chain := newSimulatedChain(t)
+	nm := chain.nonceManager(time.Hour)
+	ctx := context.Background()
+
+	const sends = 16
+	nonces := make([]uint64, sends)
+	errs := make([]error, sends)
+	var wg sync.WaitGroup
+	for i := 0; i < sends; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			nonce, err := nm.Next(ctx)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			tx := chain.transfer(t, nonce, 1, 10)
+			if err := chain.SendTransaction(ctx, tx); err != nil {
+				nm.HandleSendError(nonce, err)
+				errs[i] = err
+				return
+			}
+			nm.Track(tx)
+			nonces[i] = nonce
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("send %d: %v", i, err)
+		}
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	for i, nonce := range nonces {
+		if nonce != uint64(i) {
+			t.Fatalf("nonces = %v, want 0..%d each once", nonces, sends-1)
+		}
+	}
+
+	// Sends that reached the pool out of order are promoted once the gaps fill
+	waitFor(t, "every transaction to be pending", func() bool {
+		pending, err := chain.PendingNonceAt(ctx, chain.address)
+		return err == nil && pending == sends
+	})
+}
+
+func TestNonceManagerResyncsAfterNonceTooLow(t *testing.T) {
+	chain := newSimulatedChain(t)
+	nm := chain.nonceManager(time.Hour)
+	ctx := context.Background()
+
+	nonce, err := nm.Next(ctx)
+	if err != nil || nonce != 0 {
+		t.Fatalf("Next = %d, %v; want 0", nonce, err)
+	}
+
+	// Another process mines two transactions from the same account
+	for external := uint64(0); external < 2; external++ {
+		if err := chain.SendTransaction(ctx, chain.transfer(t, external, 1, 10)); err != nil {
+			t.Fatalf("external send %d: %v", external, err)
+		}
+	}
+	chain.mine(t)
+
+	err = chain.SendTransaction(ctx, chain.transfer(t, nonce, 1, 10))
+	if err == nil || !isNonceTooLowError(err) {
+		t.Fatalf("send at a used nonce: %v, want nonce too low", err)
+	}
+	nm.HandleSendError(nonce, err)
+
+	if next, err := nm.Next(ctx); err != nil || next != 2 {
+		t.Errorf("Next after resync = %d, %v; want 2", next, err)
+	}
+}
+
+func TestReplaceStuckBumpsPastUnderpricedRejections(t *testing.T) {
+	chain := newSimulatedChain(t)
+	nm := chain.nonceManager(0)
+	ctx := context.Background()
+
+	original := chain.transfer(t, 0, 2, 20)
+	if err := chain.SendTransaction(ctx, original); err != nil {
+		t.Fatalf("send original: %v", err)
+	}
+	nm.Track(original)
+
+	// The pool requires both fees to rise by 10% to replace a transaction
+	err := chain.SendTransaction(ctx, chain.transfer(t, 0, 2.1, 21))
+	if err == nil || !isUnderpricedError(err) || !strings.Contains(err.Error(), "replacement transaction underpriced") {
+		t.Fatalf("5%% bump: %v, want replacement transaction underpriced", err)
+	}
+
+	// Someone else replaces it with 15% higher fees, so one or two 10% bumps of the
+	// original are rejected by the pool and the third is accepted
+	external := chain.transfer(t, 0, 2.3, 23)
+	if err := chain.SendTransaction(ctx, external); err != nil {
+		t.Fatalf("send external replacement: %v", err)
+	}
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		replaced, err := nm.ReplaceStuck(ctx)
+		if err != nil {
+			t.Fatalf("attempt %d: ReplaceStuck: %v", attempt, err)
+		}
+		if len(replaced) != 0 {
+			t.Fatalf("attempt %d: replaced %d transactions, want an underpriced rejection", attempt, len(replaced))
+		}
+		nm.mu.Lock()
+		bumps := nm.pending[0].bumps
+		nm.mu.Unlock()
+		if bumps != attempt {
+			t.Errorf("attempt %d: bumps = %d, want %d", attempt, bumps, attempt)
+		}
+	}
+
+	replaced, err := nm.ReplaceStuck(ctx)
+	if err != nil {
+		t.Fatalf("ReplaceStuck: %v", err)
+	}
+	if len(replaced) != 1 {
+		t.Fatalf("replaced %d transactions, want 1", len(replaced))
+	}
+	replacement := replaced[0]
+	if replacement.Nonce() != 0 || replacement.GasFeeCap().Cmp(bumpPrice(external.GasFeeCap(), 1)) < 0 || replacement.GasTipCap().Cmp(bumpPrice(external.GasTipCap(), 1)) < 0 {
+		t.Errorf("replacement nonce %d tip %s cap %s does not outbid the external replacement", replacement.Nonce(), replacement.GasTipCap(), replacement.GasFeeCap())
+	}
+	if hash, ok := nm.ReplacementFor(original.Hash()); !ok || hash != replacement.Hash() {
+		t.Errorf("ReplacementFor(original) = %s, %v; want %s", hash.Hex(), ok, replacement.Hash().Hex())
+	}
+	if chain.pool.Get(replacement.Hash()) == nil || chain.pool.Get(external.Hash()) != nil {
+		t.Error("the pool does not hold the replacement in place of the external transaction")
+	}
+}
+
+// stubNonceBackend rejects every transaction with sendErr
+type stubNonceBackend struct {
+	pendingNonce uint64
+	sendErr      error
+
+	mu           sync.Mutex
+	pendingReads int
+}
+
+func (b *stubNonceBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pendingReads++
+	return b.pendingNonce, nil
+}
+
+func (b *stubNonceBackend) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return 0, nil
+}
+
+func (b *stubNonceBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return GweiToWei(1), nil
+}
+
+func (b *stubNonceBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return GweiToWei(1), nil
+}
+
+func (b *stubNonceBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return b.sendErr
+}
+
+func TestReplaceStuckNonceErrors(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	opts, _ := bind.NewKeyedTransactorWithChainID(key, big.NewInt(8453))
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	tests := []struct {
+		name    string
+		sendErr error
+		tracked bool
+		resyncs int
+	}{
+		{"too low means mined", errors.New("nonce too low: next nonce 4, tx nonce 3"), false, 0},
+		{"too high means a gap", errors.New("nonce too high"), true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &stubNonceBackend{pendingNonce: 3, sendErr: tt.sendErr}
+			nm := NewNonceManager(backend, address, opts.Signer, 0, nil, logger)
+
+			tx, err := opts.Signer(address, types.NewTx(&types.DynamicFeeTx{
+				ChainID:   big.NewInt(8453),
+				Nonce:     3,
+				GasTipCap: GweiToWei(1),
+				GasFeeCap: GweiToWei(2),
+				Gas:       21000,
+				To:        &address,
+			}))
+			if err != nil {
+				t.Fatalf("sign: %v", err)
+			}
+			nm.Track(tx)
+
+			if _, err := nm.ReplaceStuck(context.Background()); err != nil {
+				t.Fatalf("ReplaceStuck: %v", err)
+			}
+
+			nm.mu.Lock()
+			_, tracked := nm.pending[3]
+			nm.mu.Unlock()
+			if tracked != tt.tracked {
+				t.Errorf("still tracked = %v, want %v", tracked, tt.tracked)
+			}
+			if backend.pendingReads != tt.resyncs {
+				t.Errorf("nonce resyncs = %d, want %d", backend.pendingReads, tt.resyncs)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package web3client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ChainMismatchError is returned when the RPC endpoint is on a different chain than expected
+type ChainMismatchError struct {
+	Expected uint64
+	Actual   uint64
+}
+
+func (e *ChainMismatchError) Error() string {
+	return fmt.Sprintf("RPC endpoint is on chain %d, expected chain %d", e.Actual, e.Expected)
+}
+
+// PreflightCheck is the outcome of a single startup check
+type PreflightCheck struct {
+	Name   string
+	Passed bool
+	Detail string
+}
+
+// PreflightReport lists every startup check that was run
+type PreflightReport struct {
+	Checks []PreflightCheck
+}
+
+// Passed reports whether every check passed
+func (r *PreflightReport) Passed() bool {
+	for _, check := range r.Checks {
+		if !check.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Failures returns the checks that did not pass
+func (r *PreflightReport) Failures() []PreflightCheck {
+	var failed []PreflightCheck
+	for _, check := range r.Checks {
+		if !check.Passed {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// String formats the report with one line per check
+func (r *PreflightReport) String() string {
+	var b strings.Builder
+	for _, check := range r.Checks {
+		status := "ok"
+		if !check.Passed {
+			status = "FAIL"
+		}
+		fmt.Fprintf(&b, "  [%s] %s: %s\n", status, check.Name, check.Detail)
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func (r *PreflightReport) pass(name, format string, args ...interface{}) {
+	r.Checks = append(r.Checks, PreflightCheck{Name: name, Passed: true, Detail: fmt.Sprintf(format, args...)})
+}
+
+func (r *PreflightReport) fail(name, format string, args ...interface{}) {
+	r.Checks = append(r.Checks, PreflightCheck{Name: name, Passed: false, Detail: fmt.Sprintf(format, args...)})
+}
+
+// PreflightError is returned when startup checks fail
+type PreflightError struct {
+	Report *PreflightReport
+}
+
+func (e *PreflightError) Error() string {
+	return fmt.Sprintf("preflight failed (%d of %d checks):\n%s", len(e.Report.Failures()), len(e.Report.Checks), e.Report.String())
+}
+
+// chainIDLister is implemented by backends with several RPC endpoints
+type chainIDLister interface {
+	ChainIDs(ctx context.Context) []EndpointChainID
+}
+
+// checkChainIDs checks that every RPC endpoint is on the artifacts' chain. An endpoint
+// that cannot be queried fails the check too, since it may be failed over to later.
+func (cm *ContractManager) checkChainIDs(ctx context.Context, report *PreflightReport) bool {
+	var chainIDs []EndpointChainID
+	if lister, ok := cm.client.(chainIDLister); ok {
+		chainIDs = lister.ChainIDs(ctx)
+	} else {
+		chainID, err := cm.client.ChainID(ctx)
+		chainIDs = []EndpointChainID{{URL: "RPC", ChainID: chainID, Err: err}}
+	}
+
+	passed := true
+	for _, endpoint := range chainIDs {
+		name := "chain id " + endpoint.URL
+		switch {
+		case endpoint.Err != nil:
+			report.fail(name, "failed to query eth_chainId: %v", endpoint.Err)
+			passed = false
+		case !endpoint.ChainID.IsUint64() || endpoint.ChainID.Uint64() != cm.artifacts.ChainID:
+			mismatch := &ChainMismatchError{Expected: cm.artifacts.ChainID, Actual: endpoint.ChainID.Uint64()}
+			report.fail(name, "%v (artifacts network %q)", mismatch, cm.artifacts.Network)
+			passed = false
+		default:
+			report.pass(name, "endpoint and artifacts agree on chain %d", cm.artifacts.ChainID)
+		}
+	}
+	return passed
+}
+
+// artifactContract is a deployment artifact address expected to hold contract code
+type artifactContract struct {
+	name     string
+	address  common.Address
+	required bool
+}
+
+func (cm *ContractManager) artifactContracts() []artifactContract {
+	return []artifactContract{
+		{"vaultProxy", cm.artifacts.VaultProxy, true},
+		{"vaultImplementation", cm.artifacts.VaultImplementation, false},
+		{"controllerProxy", cm.artifacts.ControllerProxy, true},
+		{"controllerImplementation", cm.artifacts.ControllerImplementation, false},
+		{"aaveStrategy", cm.artifacts.AaveStrategy, false},
+		{"asset", cm.artifacts.Asset, true},
+	}
+}
+
+// Preflight verifies the RPC endpoint, deployment artifacts and keeper permissions
+// before any transaction is sent. The returned error is a *PreflightError carrying
+// the full report when any check fails.
+func (cm *ContractManager) Preflight(ctx context.Context) (*PreflightReport, error) {
+	report := &PreflightReport{}
+
+	// The signer is built from the artifacts' chain ID, so every RPC endpoint must agree
+	// with it; code and role lookups on the wrong chain would only add noise
+	if !cm.checkChainIDs(ctx, report) {
+		return report, &PreflightError{Report: report}
+	}
+
+	for _, contract := range cm.artifactContracts() {
+		cm.checkCode(ctx, report, contract)
+	}
+
+	keeper := cm.GetKeeperAddress()
+	if cm.artifacts.KeeperEOA != (common.Address{}) && cm.artifacts.KeeperEOA != keeper {
+		report.fail("keeper address", "private key controls %s but artifacts list keeperEOA %s", keeper.Hex(), cm.artifacts.KeeperEOA.Hex())
+	} else {
+		report.pass("keeper address", "%s", keeper.Hex())
+	}
+
+	cm.checkKeeperRole(ctx, report, keeper)
+
+	if strategies, err := cm.GetStrategies(ctx, nil); err != nil {
+		report.fail("strategies", "failed to list controller strategies: %v", err)
+	} else {
+		for _, strategy := range strategies {
+			cm.checkCode(ctx, report, artifactContract{name: "strategy " + strategy.Hex(), address: strategy, required: true})
+		}
+	}
+
+	if !report.Passed() {
+		return report, &PreflightError{Report: report}
+	}
+	return report, nil
+}
+
+// checkCode confirms a contract address has code deployed
+func (cm *ContractManager) checkCode(ctx context.Context, report *PreflightReport, contract artifactContract) {
+	name := "code at " + contract.name
+
+	if contract.address == (common.Address{}) {
+		if contract.required {
+			report.fail(name, "address missing from deployment artifacts")
+		} else {
+			report.pass(name, "not set, skipped")
+		}
+		return
+	}
+
+	code, err := cm.client.CodeAt(ctx, contract.address, nil)
+	if err != nil {
+		report.fail(name, "failed to get code at %s: %v", contract.address.Hex(), err)
+		return
+	}
+	if len(code) == 0 {
+		report.fail(name, "no contract deployed at %s", contract.address.Hex())
+		return
+	}
+
+	report.pass(name, "%s (%d bytes)", contract.address.Hex(), len(code))
+}
+
+// checkKeeperRole confirms the keeper account holds KEEPER_ROLE on the controller
+func (cm *ContractManager) checkKeeperRole(ctx context.Context, report *PreflightReport, keeper common.Address) {
+	const name = "keeper role"
+
+	role, err := cm.GetKeeperRole(ctx)
+	if err != nil {
+		report.fail(name, "failed to read KEEPER_ROLE: %v", err)
+		return
+	}
+
+	if cm.artifacts.KeeperRole != "" && common.HexToHash(cm.artifacts.KeeperRole) != role {
+		report.fail(name, "controller KEEPER_ROLE %s does not match artifacts %s", role.Hex(), cm.artifacts.KeeperRole)
+		return
+	}
+
+	granted, err := cm.HasRole(ctx, role, keeper)
+	if err != nil {
+		report.fail(name, "failed to call hasRole: %v", err)
+		return
+	}
+	if !granted {
+		report.fail(name, "%s does not hold KEEPER_ROLE on controller %s", keeper.Hex(), cm.GetControllerAddress().Hex())
+		return
+	}
+
+	report.pass(name, "%s holds KEEPER_ROLE", keeper.Hex())
+}
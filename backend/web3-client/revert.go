@@ -0,0 +1,154 @@
+package web3client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// customErrorsABI lists custom errors the Aegis contracts and their OpenZeppelin
+// dependencies can revert with
+const customErrorsABI = `[
+	{"type":"error","name":"AccessControlUnauthorizedAccount","inputs":[{"name":"account","type":"address"},{"name":"neededRole","type":"bytes32"}]},
+	{"type":"error","name":"AccessControlBadConfirmation","inputs":[]},
+	{"type":"error","name":"EnforcedPause","inputs":[]},
+	{"type":"error","name":"ExpectedPause","inputs":[]},
+	{"type":"error","name":"ReentrancyGuardReentrantCall","inputs":[]},
+	{"type":"error","name":"SafeERC20FailedOperation","inputs":[{"name":"token","type":"address"}]}
+]`
+
+var (
+	customErrors = mustParseABI(customErrorsABI)
+
+	// panicSelector is the selector of the compiler-generated Panic(uint256) error
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	// knownRoles maps AccessControl role hashes to their names
+	knownRoles = map[common.Hash]string{
+		common.Hash{}: "DEFAULT_ADMIN_ROLE",
+		crypto.Keccak256Hash([]byte("ADMIN_ROLE")):      "ADMIN_ROLE",
+		crypto.Keccak256Hash([]byte("KEEPER_ROLE")):     "KEEPER_ROLE",
+		crypto.Keccak256Hash([]byte("STRATEGIST_ROLE")): "STRATEGIST_ROLE",
+		crypto.Keccak256Hash([]byte("CONTROLLER_ROLE")): "CONTROLLER_ROLE",
+	}
+
+	// missingRolePattern matches the OpenZeppelin v4 AccessControl revert string
+	missingRolePattern = regexp.MustCompile(`is missing role (0x[0-9a-fA-F]{64})`)
+)
+
+// panicReasons describes the Solidity panic codes
+var panicReasons = map[uint64]string{
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to uninitialized function",
+}
+
+// RevertError is returned when a contract call or transaction reverts
+type RevertError struct {
+	Reason string
+	Data   []byte
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("execution reverted: %s", e.Reason)
+}
+
+// DecodeRevert returns a human readable reason for ABI-encoded revert data
+func DecodeRevert(data []byte) string {
+	if len(data) == 0 {
+		return "no revert data"
+	}
+
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return annotateRoles(reason)
+	}
+
+	if len(data) >= 4 && bytes.Equal(data[:4], panicSelector) && len(data) == 36 {
+		code := new(big.Int).SetBytes(data[4:]).Uint64()
+		if reason, ok := panicReasons[code]; ok {
+			return fmt.Sprintf("panic: %s (0x%02x)", reason, code)
+		}
+		return fmt.Sprintf("panic: code 0x%02x", code)
+	}
+
+	if len(data) >= 4 {
+		if customErr, err := customErrors.ErrorByID([4]byte(data[:4])); err == nil {
+			return decodeCustomError(customErr, data[4:])
+		}
+	}
+
+	return fmt.Sprintf("unknown revert data %s", hexutil.Encode(data))
+}
+
+// decodeCustomError formats a custom error with its arguments
+func decodeCustomError(customErr *abi.Error, data []byte) string {
+	values, err := customErr.Inputs.Unpack(data)
+	if err != nil {
+		return customErr.Name
+	}
+
+	args := make([]string, len(values))
+	for i, value := range values {
+		switch v := value.(type) {
+		case common.Address:
+			args[i] = v.Hex()
+		case [32]byte:
+			args[i] = roleName(common.Hash(v))
+		default:
+			args[i] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return fmt.Sprintf("%s(%s)", customErr.Name, strings.Join(args, ", "))
+}
+
+// annotateRoles replaces role hashes in OpenZeppelin v4 revert strings with their names
+func annotateRoles(reason string) string {
+	return missingRolePattern.ReplaceAllStringFunc(reason, func(match string) string {
+		hash := common.HexToHash(missingRolePattern.FindStringSubmatch(match)[1])
+		return "is missing role " + roleName(hash)
+	})
+}
+
+func roleName(role common.Hash) string {
+	if name, ok := knownRoles[role]; ok {
+		return name
+	}
+	return role.Hex()
+}
+
+// decodeRevertError converts an RPC error carrying revert data into a RevertError.
+// Errors without revert data are returned unchanged.
+func decodeRevertError(err error) error {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+
+	encoded, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return err
+	}
+
+	data, decodeErr := hexutil.Decode(encoded)
+	if decodeErr != nil {
+		return err
+	}
+
+	return &RevertError{Reason: DecodeRevert(data), Data: data}
+}
@@ -0,0 +1,226 @@
+package web3client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Signer signs keeper transactions
+type Signer interface {
+	// Address is the account transactions are signed for
+	Address() common.Address
+	// SignTx returns tx signed for the given chain
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// NewTransactOpts creates transaction options that sign with signer for the given chain
+func NewTransactOpts(ctx context.Context, signer Signer, chainID *big.Int) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:    signer.Address(),
+		Signer:  SignerFn(ctx, signer, chainID),
+		Context: ctx,
+	}
+}
+
+// SignerFn adapts a Signer to go-ethereum's bind.SignerFn
+func SignerFn(ctx context.Context, signer Signer, chainID *big.Int) bind.SignerFn {
+	return func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != signer.Address() {
+			return nil, bind.ErrNotAuthorized
+		}
+		return signer.SignTx(ctx, tx, chainID)
+	}
+}
+
+// keySigner signs with an in-memory private key
+type keySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+func newKeySigner(key *ecdsa.PrivateKey) keySigner {
+	return keySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// Address implements Signer
+func (s keySigner) Address() common.Address { return s.address }
+
+// SignTx implements Signer
+func (s keySigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+}
+
+// RawKeySigner signs with a plaintext hex private key. It is meant for local
+// development only; use KeystoreSigner or RemoteSigner in production.
+type RawKeySigner struct {
+	keySigner
+}
+
+// NewRawKeySigner creates a signer from a hex private key, with or without 0x prefix
+func NewRawKeySigner(privateKeyHex string) (*RawKeySigner, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key: %w", err)
+	}
+	return &RawKeySigner{newKeySigner(key)}, nil
+}
+
+// KeystoreSigner signs with a key decrypted from an encrypted geth keystore file
+type KeystoreSigner struct {
+	keySigner
+}
+
+// NewKeystoreSigner decrypts a geth keystore JSON file with the passphrase stored in
+// passphraseFile. Trailing newlines in the passphrase file are ignored.
+func NewKeystoreSigner(keystoreFile, passphraseFile string) (*KeystoreSigner, error) {
+	keyJSON, err := os.ReadFile(keystoreFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore: %w", err)
+	}
+
+	passphrase, err := os.ReadFile(passphraseFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore passphrase: %w", err)
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, strings.TrimRight(string(passphrase), "\r\n"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore %s: %w", keystoreFile, err)
+	}
+
+	return &KeystoreSigner{newKeySigner(key.PrivateKey)}, nil
+}
+
+// defaultRemoteSignerTimeout bounds a single eth_signTransaction request
+const defaultRemoteSignerTimeout = 10 * time.Second
+
+// RemoteSigner signs through an external signer's eth_signTransaction JSON-RPC
+// method (web3signer, Clef and geth compatible). The key never leaves the signer.
+type RemoteSigner struct {
+	client  *rpc.Client
+	address common.Address
+	timeout time.Duration
+}
+
+// NewRemoteSigner connects to an external signer that holds the key for address
+func NewRemoteSigner(ctx context.Context, url string, address common.Address) (*RemoteSigner, error) {
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer: %w", err)
+	}
+
+	return &RemoteSigner{
+		client:  client,
+		address: address,
+		timeout: defaultRemoteSignerTimeout,
+	}, nil
+}
+
+// Address implements Signer
+func (s *RemoteSigner) Address() common.Address { return s.address }
+
+// signTxArgs are the eth_signTransaction parameters
+type signTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+// SignTx implements Signer. The returned transaction is checked to be signed by the
+// expected account over exactly the requested fields.
+func (s *RemoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := signTxArgs{
+		From:    s.address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	default:
+		return nil, fmt.Errorf("remote signer does not support transaction type %d", tx.Type())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var result json.RawMessage
+	if err := s.client.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
+		return nil, fmt.Errorf("remote signer eth_signTransaction failed: %w", err)
+	}
+
+	raw, err := decodeSignResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("remote signer returned an invalid transaction: %w", err)
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("remote signer returned a transaction that differs from the request")
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover remote signature: %w", err)
+	}
+	if sender != s.address {
+		return nil, fmt.Errorf("remote signer signed with %s, expected %s", sender.Hex(), s.address.Hex())
+	}
+
+	return signed, nil
+}
+
+// Close closes the connection to the remote signer
+func (s *RemoteSigner) Close() {
+	s.client.Close()
+}
+
+// decodeSignResult accepts both the raw hex string returned by web3signer and the
+// {"raw": ..., "tx": ...} object returned by geth and Clef
+func decodeSignResult(result json.RawMessage) ([]byte, error) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err == nil {
+		return raw, nil
+	}
+
+	var envelope struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	if err := json.Unmarshal(result, &envelope); err != nil || len(envelope.Raw) == 0 {
+		return nil, fmt.Errorf("unexpected eth_signTransaction result %s", string(result))
+	}
+	return envelope.Raw, nil
+}
@@ -0,0 +1,253 @@
+package web3client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+)
+
+// TxStatus is the final state of a tracked transaction
+type TxStatus string
+
+const (
+	// TxStatusSuccess means the transaction was mined and executed successfully
+	TxStatusSuccess TxStatus = "mined_success"
+	// TxStatusReverted means the transaction was mined but execution reverted
+	TxStatusReverted TxStatus = "mined_reverted"
+	// TxStatusDropped means the transaction disappeared without its nonce being used
+	TxStatusDropped TxStatus = "dropped"
+	// TxStatusReplaced means another transaction from the same sender used its nonce
+	TxStatusReplaced TxStatus = "replaced"
+)
+
+// ErrTrackTimeout is returned when a transaction is still pending after the tracker timeout
+var ErrTrackTimeout = errors.New("timed out waiting for transaction")
+
+// TxResult is the outcome of tracking a transaction
+type TxResult struct {
+	Hash          common.Hash
+	Status        TxStatus
+	Receipt       *types.Receipt // Set for mined transactions
+	Confirmations uint64
+	Reorgs        int // Number of times the receipt moved due to a chain reorganisation
+}
+
+// TrackerConfig configures how transactions are tracked
+type TrackerConfig struct {
+	// Confirmations is the number of blocks, including the inclusion block, required before returning
+	Confirmations uint64
+	// PollInterval is the delay between receipt lookups
+	PollInterval time.Duration
+	// Timeout bounds the total wait, zero means wait until the context is done
+	Timeout time.Duration
+	// DropTimeout is how long a transaction may be unknown to the node before it is considered dropped
+	DropTimeout time.Duration
+}
+
+// DefaultTrackerConfig returns tracker settings suited to Base's 2 second blocks
+func DefaultTrackerConfig() TrackerConfig {
+	return TrackerConfig{
+		Confirmations: 3,
+		PollInterval:  2 * time.Second,
+		Timeout:       5 * time.Minute,
+		DropTimeout:   time.Minute,
+	}
+}
+
+// trackerBackend is the subset of the RPC client used by TxTracker
+type trackerBackend interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// TxTracker waits for transactions by hash and classifies their outcome
+type TxTracker struct {
+	client trackerBackend
+	config TrackerConfig
+	logger *logrus.Logger
+}
+
+// NewTxTracker creates a new transaction tracker
+func NewTxTracker(client trackerBackend, config TrackerConfig, logger *logrus.Logger) *TxTracker {
+	if config.Confirmations == 0 {
+		config.Confirmations = 1
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultTrackerConfig().PollInterval
+	}
+	if config.DropTimeout <= 0 {
+		config.DropTimeout = DefaultTrackerConfig().DropTimeout
+	}
+
+	return &TxTracker{
+		client: client,
+		config: config,
+		logger: logger,
+	}
+}
+
+// trackState is what the tracker has learned about a transaction so far
+type trackState struct {
+	sender       *common.Address
+	nonce        uint64
+	blockHash    common.Hash
+	unknownSince time.Time
+	reorgs       int
+}
+
+// Wait blocks until the transaction has the configured number of confirmations,
+// is dropped or replaced, or the timeout expires
+func (t *TxTracker) Wait(ctx context.Context, txHash common.Hash) (*TxResult, error) {
+	if t.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.config.Timeout)
+		defer cancel()
+	}
+
+	state := &trackState{unknownSince: time.Now()}
+
+	ticker := time.NewTicker(t.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		result, err := t.poll(ctx, txHash, state)
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			t.logger.WithField("txHash", txHash.Hex()).WithError(err).Warn("Transaction status poll failed")
+		}
+		if result != nil {
+			return result, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("%w %s", ErrTrackTimeout, txHash.Hex())
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll checks the transaction once and returns a result when it reaches a final state
+func (t *TxTracker) poll(ctx context.Context, txHash common.Hash, state *trackState) (*TxResult, error) {
+	receipt, err := t.client.TransactionReceipt(ctx, txHash)
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return nil, err
+	}
+
+	if receipt != nil {
+		return t.checkReceipt(ctx, txHash, receipt, state)
+	}
+
+	// A receipt we saw before has disappeared: the block was reorged out
+	if state.blockHash != (common.Hash{}) {
+		t.recordReorg(txHash, state)
+	}
+
+	return t.checkPending(ctx, txHash, state)
+}
+
+// checkReceipt verifies the receipt is still canonical and counts confirmations
+func (t *TxTracker) checkReceipt(ctx context.Context, txHash common.Hash, receipt *types.Receipt, state *trackState) (*TxResult, error) {
+	if state.blockHash != (common.Hash{}) && state.blockHash != receipt.BlockHash {
+		t.recordReorg(txHash, state)
+	}
+	state.blockHash = receipt.BlockHash
+	state.unknownSince = time.Now()
+
+	canonical, err := t.client.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if canonical.Hash() != receipt.BlockHash {
+		// The node has not caught up with the reorg yet; wait for the receipt to move
+		return nil, nil
+	}
+
+	head, err := t.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if head.Number.Cmp(receipt.BlockNumber) < 0 {
+		return nil, nil
+	}
+
+	confirmations := new(big.Int).Sub(head.Number, receipt.BlockNumber).Uint64() + 1
+	if confirmations < t.config.Confirmations {
+		return nil, nil
+	}
+
+	status := TxStatusSuccess
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		status = TxStatusReverted
+	}
+
+	return &TxResult{
+		Hash:          txHash,
+		Status:        status,
+		Receipt:       receipt,
+		Confirmations: confirmations,
+		Reorgs:        state.reorgs,
+	}, nil
+}
+
+// checkPending classifies a transaction that has no receipt
+func (t *TxTracker) checkPending(ctx context.Context, txHash common.Hash, state *trackState) (*TxResult, error) {
+	tx, _, err := t.client.TransactionByHash(ctx, txHash)
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return nil, err
+	}
+
+	if tx != nil {
+		if state.sender == nil {
+			sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to recover sender: %w", err)
+			}
+			state.sender = &sender
+			state.nonce = tx.Nonce()
+		}
+		state.unknownSince = time.Now()
+		return nil, nil
+	}
+
+	// The node no longer knows the transaction; check whether its nonce was used
+	if state.sender != nil {
+		nonce, err := t.client.NonceAt(ctx, *state.sender, nil)
+		if err != nil {
+			return nil, err
+		}
+		if nonce > state.nonce {
+			// Re-check the receipt in case it was mined between the two lookups
+			if receipt, err := t.client.TransactionReceipt(ctx, txHash); err == nil && receipt != nil {
+				return t.checkReceipt(ctx, txHash, receipt, state)
+			}
+			return &TxResult{Hash: txHash, Status: TxStatusReplaced, Reorgs: state.reorgs}, nil
+		}
+	}
+
+	if time.Since(state.unknownSince) >= t.config.DropTimeout {
+		return &TxResult{Hash: txHash, Status: TxStatusDropped, Reorgs: state.reorgs}, nil
+	}
+
+	return nil, nil
+}
+
+func (t *TxTracker) recordReorg(txHash common.Hash, state *trackState) {
+	state.reorgs++
+	t.logger.WithFields(logrus.Fields{
+		"txHash":        txHash.Hex(),
+		"previousBlock": state.blockHash.Hex(),
+	}).Warn("Transaction receipt affected by chain reorganisation")
+	state.blockHash = common.Hash{}
+}
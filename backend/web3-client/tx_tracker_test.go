@@ -0,0 +1,266 @@
+package web3client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sirupsen/logrus"
+)
+
+// stubChain is a scripted trackerBackend. step runs before every receipt lookup so a
+// test can move the chain along between polls.
+type stubChain struct {
+	mu        sync.Mutex
+	head      uint64
+	canonical map[uint64]*types.Header
+	receipt   *types.Receipt
+	pending   *types.Transaction
+	nonce     uint64
+	polls     int
+	step      func(c *stubChain, poll int)
+}
+
+func newStubChain(head uint64) *stubChain {
+	c := &stubChain{canonical: make(map[uint64]*types.Header)}
+	c.advance(head)
+	return c
+}
+
+// advance extends the canonical chain up to head
+func (c *stubChain) advance(head uint64) {
+	for number := c.head + 1; number <= head; number++ {
+		c.canonical[number] = &types.Header{Number: new(big.Int).SetUint64(number)}
+	}
+	c.head = head
+}
+
+// fork replaces the canonical block at number with a sibling
+func (c *stubChain) fork(number uint64) *types.Header {
+	c.canonical[number] = &types.Header{Number: new(big.Int).SetUint64(number), Extra: []byte("fork")}
+	return c.canonical[number]
+}
+
+// mine includes the transaction in the canonical block at number
+func (c *stubChain) mine(number uint64, status uint64) {
+	c.pending = nil
+	c.receipt = &types.Receipt{
+		Status:      status,
+		BlockHash:   c.canonical[number].Hash(),
+		BlockNumber: new(big.Int).SetUint64(number),
+	}
+}
+
+func (c *stubChain) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.polls++
+	if c.step != nil {
+		c.step(c, c.polls)
+	}
+	if c.receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	return c.receipt, nil
+}
+
+func (c *stubChain) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.pending == nil {
+		return nil, false, ethereum.NotFound
+	}
+	return c.pending, true, nil
+}
+
+func (c *stubChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if number == nil {
+		return c.canonical[c.head], nil
+	}
+	header, ok := c.canonical[number.Uint64()]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return header, nil
+}
+
+func (c *stubChain) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.nonce, nil
+}
+
+// signedTx returns a signed transaction with nonce 5
+func signedTx(t *testing.T) *types.Transaction {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainID := big.NewInt(8453)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID: chainID,
+		Nonce:   5,
+		Gas:     21000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func trackTx(t *testing.T, chain *stubChain, config TrackerConfig) (*TxResult, error) {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	if config.PollInterval == 0 {
+		config.PollInterval = time.Millisecond
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 5 * time.Second
+	}
+	return NewTxTracker(chain, config, logger).Wait(context.Background(), common.HexToHash("0x1"))
+}
+
+func TestTxTrackerMined(t *testing.T) {
+	tests := []struct {
+		name   string
+		status uint64
+		want   TxStatus
+	}{
+		{"success", types.ReceiptStatusSuccessful, TxStatusSuccess},
+		{"reverted", types.ReceiptStatusFailed, TxStatusReverted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Mined in the head block, then one block per poll
+			chain := newStubChain(10)
+			chain.mine(10, tt.status)
+			chain.step = func(c *stubChain, poll int) { c.advance(9 + uint64(poll)) }
+
+			result, err := trackTx(t, chain, TrackerConfig{Confirmations: 3})
+			if err != nil {
+				t.Fatalf("Wait: %v", err)
+			}
+			if result.Status != tt.want {
+				t.Errorf("status = %s, want %s", result.Status, tt.want)
+			}
+			if result.Confirmations != 3 || chain.head != 12 {
+				t.Errorf("returned with %d confirmations at head %d, want 3 at head 12", result.Confirmations, chain.head)
+			}
+			if result.Receipt == nil || result.Reorgs != 0 {
+				t.Errorf("result = %+v, want the receipt and no reorgs", result)
+			}
+		})
+	}
+}
+
+func TestTxTrackerReorg(t *testing.T) {
+	chain := newStubChain(12)
+	chain.mine(10, types.ReceiptStatusSuccessful)
+	original := chain.receipt.BlockHash
+
+	var forked common.Hash
+	chain.step = func(c *stubChain, poll int) {
+		switch poll {
+		case 1:
+			// The node has switched to a fork but still serves the stale receipt
+			forked = c.fork(10).Hash()
+		case 3:
+			// The transaction is included again in the fork's block
+			c.mine(10, types.ReceiptStatusSuccessful)
+		}
+	}
+
+	result, err := trackTx(t, chain, TrackerConfig{Confirmations: 3})
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if chain.polls != 3 {
+		t.Errorf("returned after %d polls, want to wait for the receipt to move", chain.polls)
+	}
+	if result.Reorgs != 1 {
+		t.Errorf("reorgs = %d, want 1", result.Reorgs)
+	}
+	if result.Receipt.BlockHash != forked || forked == original {
+		t.Errorf("receipt block = %s, want the fork's %s", result.Receipt.BlockHash.Hex(), forked.Hex())
+	}
+}
+
+func TestTxTrackerReceiptDisappears(t *testing.T) {
+	chain := newStubChain(10)
+	chain.mine(10, types.ReceiptStatusSuccessful)
+	chain.step = func(c *stubChain, poll int) {
+		switch poll {
+		case 2:
+			// The block is reorged out and the receipt is gone
+			c.fork(10)
+			c.receipt = nil
+		case 4:
+			c.advance(12)
+			c.mine(11, types.ReceiptStatusSuccessful)
+		}
+	}
+
+	result, err := trackTx(t, chain, TrackerConfig{Confirmations: 2})
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if result.Status != TxStatusSuccess || result.Reorgs != 1 || result.Receipt.BlockNumber.Uint64() != 11 {
+		t.Errorf("result = %+v, want success in block 11 after one reorg", result)
+	}
+}
+
+func TestTxTrackerPendingOutcomes(t *testing.T) {
+	tests := []struct {
+		name  string
+		nonce uint64 // Account nonce once the transaction leaves the pool
+		want  TxStatus
+	}{
+		{"dropped", 5, TxStatusDropped},
+		{"replaced", 6, TxStatusReplaced},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newStubChain(10)
+			chain.nonce = 5
+			chain.pending = signedTx(t)
+			chain.step = func(c *stubChain, poll int) {
+				if poll == 3 {
+					c.pending = nil
+					c.nonce = tt.nonce
+				}
+			}
+
+			result, err := trackTx(t, chain, TrackerConfig{DropTimeout: 20 * time.Millisecond})
+			if err != nil {
+				t.Fatalf("Wait: %v", err)
+			}
+			if result.Status != tt.want || result.Receipt != nil {
+				t.Errorf("result = %+v, want %s without a receipt", result, tt.want)
+			}
+		})
+	}
+}
+
+func TestTxTrackerTimeout(t *testing.T) {
+	chain := newStubChain(10)
+	chain.pending = signedTx(t)
+
+	_, err := trackTx(t, chain, TrackerConfig{Timeout: 50 * time.Millisecond, DropTimeout: time.Minute})
+	if !errors.Is(err, ErrTrackTimeout) {
+		t.Fatalf("Wait error = %v, want ErrTrackTimeout", err)
+	}
+}
@@ -104,10 +104,15 @@ def predict():
             else:
                 apy, volatility = 0.05, 0.10
             
+            # 90% interval of the APY over the horizon
+            interval = 1.645 * volatility * np.sqrt(horizon_days / 365)
+            
             predictions[strategy] = {
                 'apy': apy,
                 'volatility': volatility,
                 'confidence': 0.85,
+                'apy_lower': float(apy - interval),
+                'apy_upper': float(apy + interval),
                 'horizon_days': horizon_days
             }
         